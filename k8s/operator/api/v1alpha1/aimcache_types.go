@@ -45,6 +45,32 @@ type StorageSpec struct {
 
 	// Mount path in containers
 	MountPath string `json:"mountPath,omitempty"`
+
+	// Tiers splits the cache across multiple storage tiers (e.g. hot NVMe,
+	// warm, cold object-gateway-backed storage), ordered hottest first. A
+	// model migrates down the list as it cools off and up the list if it
+	// heats back up. Leave unset for the original single-PVC behavior.
+	Tiers []StorageTierSpec `json:"tiers,omitempty"`
+}
+
+// StorageTierSpec defines one storage tier backing a cache, ordered
+// hottest-to-coldest within StorageSpec.Tiers.
+type StorageTierSpec struct {
+	// Name identifies this tier, e.g. "hot", "warm", "cold"
+	Name string `json:"name"`
+
+	// Storage class backing this tier's PVC
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// Size of this tier's PVC
+	Size string `json:"size"`
+
+	// AccessThreshold is the minimum AccessCount a model needs to stay on
+	// this tier; a model falling below every tier's threshold settles on the
+	// coldest tier. It is compared against CachedModelStatus.AccessCount as
+	// reported, a running count rather than a per-day rate. Leave unset on a
+	// tier to accept any model that doesn't qualify for a hotter one.
+	AccessThreshold *int64 `json:"accessThreshold,omitempty"`
 }
 
 // ModelCacheSpec defines model cache configuration
@@ -63,6 +89,10 @@ type ModelCacheSpec struct {
 
 	// Preload this model
 	Preload *bool `json:"preload,omitempty"`
+
+	// Checksum the puller must verify (as "<algorithm>:<hex digest>", e.g.
+	// "sha256:abcd...") before marking a preloaded model cached
+	Checksum string `json:"checksum,omitempty"`
 }
 
 // CleanupSpec defines cleanup configuration
@@ -102,6 +132,10 @@ type AIMCacheStatus struct {
 
 	// Usage statistics
 	Usage *CacheUsageStatus `json:"usage,omitempty"`
+
+	// ReferencedBy lists the AIMEndpoints currently bound to this cache via
+	// an AIMCacheBinding, computed fresh on every reconcile
+	ReferencedBy []string `json:"referencedBy,omitempty"`
 }
 
 // StorageStatus defines storage status
@@ -118,7 +152,28 @@ type StorageStatus struct {
 	// Usage percentage
 	UsagePercentage *float64 `json:"usagePercentage,omitempty"`
 
-	// PVC name
+	// PVC name, set only when Storage.Tiers is unset
+	PVCName string `json:"pvcName,omitempty"`
+
+	// PVC phase, set only when Storage.Tiers is unset
+	PVCPhase string `json:"pvcPhase,omitempty"`
+
+	// Tiers reports per-tier usage when Storage.Tiers is set
+	Tiers []StorageTierStatus `json:"tiers,omitempty"`
+}
+
+// StorageTierStatus reports one storage tier's usage
+type StorageTierStatus struct {
+	// Name of the tier, matching a StorageTierSpec.Name
+	Name string `json:"name"`
+
+	// Total size of this tier's PVC
+	TotalSize string `json:"totalSize,omitempty"`
+
+	// Used size, computed from models currently assigned to this tier
+	UsedSize string `json:"usedSize,omitempty"`
+
+	// PVC name backing this tier
 	PVCName string `json:"pvcName,omitempty"`
 
 	// PVC phase
@@ -142,8 +197,18 @@ type CachedModelStatus struct {
 	// Access count
 	AccessCount *int64 `json:"accessCount,omitempty"`
 
-	// Status
+	// Status of the model: pending, downloading, cached, or failed
 	Status string `json:"status,omitempty"`
+
+	// Download progress as a percentage (0-100), set while Status is downloading
+	DownloadProgress *float64 `json:"downloadProgress,omitempty"`
+
+	// Tier is the storage tier this model currently lives on, matching a
+	// StorageTierSpec.Name. Empty when Storage.Tiers is unset.
+	Tier string `json:"tier,omitempty"`
+
+	// LastMigration is when this model was last moved between tiers
+	LastMigration *metav1.Time `json:"lastMigration,omitempty"`
 }
 
 // CacheUsageStatus defines cache usage statistics
@@ -159,6 +224,9 @@ type CacheUsageStatus struct {
 
 	// Next cleanup timestamp
 	NextCleanup *metav1.Time `json:"nextCleanup,omitempty"`
+
+	// LastMigration is when the tier-migration pass last ran
+	LastMigration *metav1.Time `json:"lastMigration,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -189,4 +257,4 @@ type AIMCacheList struct {
 
 func init() {
 	SchemeBuilder.Register(&AIMCache{}, &AIMCacheList{})
-} 
\ No newline at end of file
+}
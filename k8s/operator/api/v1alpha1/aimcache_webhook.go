@@ -0,0 +1,98 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// ValidCleanupStrategies lists every CleanupSpec.Strategy value the
+// controller's evictor subsystem knows how to run.
+var ValidCleanupStrategies = []string{"lru", "lfu", "age", "priority-weighted"}
+
+//+kubebuilder:webhook:path=/validate-aim-engine-amd-com-v1alpha1-aimcache,mutating=false,failurePolicy=fail,sideEffects=None,groups=aim.engine.amd.com,resources=aimcaches,verbs=create;update,versions=v1alpha1,name=vaimcache.kb.io,admissionReviewVersions=v1
+
+// AIMCacheCustomValidator rejects an AIMCache with an unknown cleanup
+// strategy or a malformed schedule/maxAge/minFreeSpace at apply time,
+// instead of letting the controller discover it every reconcile.
+type AIMCacheCustomValidator struct{}
+
+var _ admission.CustomValidator = &AIMCacheCustomValidator{}
+
+// SetupAIMCacheWebhookWithManager registers the validating webhook with the manager
+func SetupAIMCacheWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&AIMCache{}).
+		WithValidator(&AIMCacheCustomValidator{}).
+		Complete()
+}
+
+// ValidateCreate implements admission.CustomValidator
+func (v *AIMCacheCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, ValidateCacheSpec(obj.(*AIMCache))
+}
+
+// ValidateUpdate implements admission.CustomValidator
+func (v *AIMCacheCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, ValidateCacheSpec(newObj.(*AIMCache))
+}
+
+// ValidateDelete implements admission.CustomValidator
+func (v *AIMCacheCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateCacheSpec is the shared validation entry point used by the
+// admission webhook.
+func ValidateCacheSpec(cache *AIMCache) error {
+	cleanup := cache.Spec.Cleanup
+	if cleanup == nil {
+		return nil
+	}
+
+	if cleanup.Strategy != "" && !containsValue(ValidCleanupStrategies, cleanup.Strategy) {
+		return fmt.Errorf("invalid cleanup strategy: %s", cleanup.Strategy)
+	}
+
+	if cleanup.Schedule != "" {
+		if _, err := cron.ParseStandard(cleanup.Schedule); err != nil {
+			return fmt.Errorf("invalid cleanup schedule %q: %w", cleanup.Schedule, err)
+		}
+	}
+
+	if cleanup.MaxAge != "" {
+		if _, err := time.ParseDuration(cleanup.MaxAge); err != nil {
+			return fmt.Errorf("invalid cleanup maxAge %q: %w", cleanup.MaxAge, err)
+		}
+	}
+
+	if cleanup.MinFreeSpace != "" {
+		if _, err := resource.ParseQuantity(cleanup.MinFreeSpace); err != nil {
+			return fmt.Errorf("invalid cleanup minFreeSpace %q: %w", cleanup.MinFreeSpace, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AIMCacheBindingSpec records that one AIMEndpoint depends on one AIMCache,
+// so AIMCacheReconciler can tell exactly who depends on a cache before acting
+// on its deletion instead of scanning every AIMEndpoint's full spec.
+type AIMCacheBindingSpec struct {
+	// EndpointName is the AIMEndpoint that created this binding.
+	EndpointName string `json:"endpointName"`
+
+	// CacheName is the AIMCache the endpoint depends on.
+	CacheName string `json:"cacheName"`
+}
+
+// AIMCacheBindingStatus defines the observed state of AIMCacheBinding
+type AIMCacheBindingStatus struct {
+	// Conditions represent the latest available observations of an object's state
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".spec.endpointName"
+//+kubebuilder:printcolumn:name="Cache",type="string",JSONPath=".spec.cacheName"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// AIMCacheBinding is the Schema for the aimcachebindings API. AIMEndpointReconciler
+// creates one per AIMCache an endpoint actually depends on and deletes it (via
+// its own finalizer) before the endpoint itself is removed, so
+// AIMCacheStatus.ReferencedBy never lags behind reality.
+type AIMCacheBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AIMCacheBindingSpec   `json:"spec,omitempty"`
+	Status AIMCacheBindingStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AIMCacheBindingList contains a list of AIMCacheBinding
+type AIMCacheBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AIMCacheBinding `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AIMCacheBinding{}, &AIMCacheBindingList{})
+}
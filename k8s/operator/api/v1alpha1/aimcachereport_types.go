@@ -0,0 +1,109 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AIMCacheReportSpec identifies the AIMCache and node an aim-cache-agent
+// instance reports measurements for. One agent pod creates and owns exactly
+// one AIMCacheReport, named after the pod.
+type AIMCacheReportSpec struct {
+	// CacheName is the AIMCache this report measures.
+	CacheName string `json:"cacheName"`
+
+	// NodeName is the node the reporting agent runs on.
+	NodeName string `json:"nodeName"`
+}
+
+// ModelUsageReport is one agent's on-disk measurement of a single cached model.
+type ModelUsageReport struct {
+	// ID is the model ID, matching ModelCacheSpec.ID / CachedModelStatus.ID.
+	ID string `json:"id"`
+
+	// SizeBytes is the total size of the model's directory on disk.
+	SizeBytes int64 `json:"sizeBytes"`
+
+	// InodeCount is the number of files/directories the model occupies,
+	// useful for spotting cache corruption (e.g. a partially-deleted model).
+	InodeCount int64 `json:"inodeCount,omitempty"`
+
+	// LastAccessTime is the most recent atime the agent observed among the
+	// model's files.
+	LastAccessTime *metav1.Time `json:"lastAccessTime,omitempty"`
+
+	// AccessCount is the agent's running count of accesses it has observed
+	// for this model since it started watching the mount.
+	AccessCount *int64 `json:"accessCount,omitempty"`
+}
+
+// AIMCacheReportStatus carries one agent's latest measurement of the cache mount.
+type AIMCacheReportStatus struct {
+	// Conditions represent the latest available observations of the agent's state
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// TotalBytes is the total capacity of the mount as seen by this agent.
+	TotalBytes *int64 `json:"totalBytes,omitempty"`
+
+	// FreeBytes is the free space remaining on the mount.
+	FreeBytes *int64 `json:"freeBytes,omitempty"`
+
+	// Models is this agent's per-model usage measurement.
+	Models []ModelUsageReport `json:"models,omitempty"`
+
+	// ObservedAt is when the agent last walked the mount.
+	ObservedAt *metav1.Time `json:"observedAt,omitempty"`
+
+	// Leader is true if this agent currently holds the measurement lease
+	// among the agents sharing a ReadWriteMany mount. AIMCacheReconciler
+	// prefers the leader's report when more than one is present; a
+	// ReadWriteOnce mount only ever has one reporting agent, which is
+	// always its own leader.
+	Leader bool `json:"leader,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Cache",type="string",JSONPath=".spec.cacheName"
+//+kubebuilder:printcolumn:name="Node",type="string",JSONPath=".spec.nodeName"
+//+kubebuilder:printcolumn:name="Leader",type="boolean",JSONPath=".status.leader"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// AIMCacheReport is the Schema for the aimcachereports API. It is written by
+// aim-cache-agent DaemonSet/Job pods, never by AIMCacheReconciler, which only
+// reads these reports to populate AIMCacheStatus with real usage data.
+type AIMCacheReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AIMCacheReportSpec   `json:"spec,omitempty"`
+	Status AIMCacheReportStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AIMCacheReportList contains a list of AIMCacheReport
+type AIMCacheReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AIMCacheReport `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AIMCacheReport{}, &AIMCacheReportList{})
+}
@@ -17,9 +17,17 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// AnnotationDryRunRecipeSelection, when set to "true" on an AIMEndpoint,
+// makes the reconciler compute and publish Status.RecipeSelection without
+// creating the Deployment, Service, or any other owned resource. This lets a
+// user preview which recipe a change in Resources/Recipe would select before
+// committing to it.
+const AnnotationDryRunRecipeSelection = "aim.engine.amd.com/dry-run"
+
 // AIMEndpointSpec defines the desired state of AIMEndpoint
 type AIMEndpointSpec struct {
 	// Model configuration
@@ -31,6 +39,11 @@ type AIMEndpointSpec struct {
 	// Resource configuration
 	Resources ResourceSpec `json:"resources,omitempty"`
 
+	// Topology selects single-node or multi-node serving. Multi-node is
+	// required for models that need tensor/pipeline parallelism across more
+	// GPUs than fit on one node (e.g. a 405B-class model on 2x8 MI300X)
+	Topology *ServingTopologySpec `json:"topology,omitempty"`
+
 	// Scaling configuration
 	Scaling ScalingSpec `json:"scaling,omitempty"`
 
@@ -51,6 +64,42 @@ type AIMEndpointSpec struct {
 
 	// Image configuration
 	Image ImageSpec `json:"image,omitempty"`
+
+	// Inference protocol configuration
+	InferenceProtocol *InferenceProtocolSpec `json:"inferenceProtocol,omitempty"`
+}
+
+// InferenceProtocolSpec defines the wire protocol exposed by the endpoint and
+// an optional KServe-style predictor graph of pre/post-processing stages
+type InferenceProtocolSpec struct {
+	// Protocol is the inference protocol to expose, in addition to the default service.
+	// One of: openai, v1, v2-grpc, v2-http
+	Protocol string `json:"protocol,omitempty"`
+
+	// Predictor describes the transformer/predictor/explainer pipeline
+	Predictor *PredictorSpec `json:"predictor,omitempty"`
+}
+
+// PredictorSpec defines the KServe-style predictor graph. Requests flow
+// Transformer -> predictor (the existing AIMEndpoint model server) -> Explainer.
+type PredictorSpec struct {
+	// Transformer pre-processes requests before they reach the predictor
+	Transformer *InferenceStageSpec `json:"transformer,omitempty"`
+
+	// Explainer post-processes predictor responses to add explanations
+	Explainer *InferenceStageSpec `json:"explainer,omitempty"`
+}
+
+// InferenceStageSpec defines a single sidecar stage of the predictor graph
+type InferenceStageSpec struct {
+	// Container image for this stage
+	Image string `json:"image"`
+
+	// Resource requirements for this stage
+	Resources *ResourceRequirements `json:"resources,omitempty"`
+
+	// Environment variables for this stage
+	Env []EnvVar `json:"env,omitempty"`
 }
 
 // ModelSpec defines the model configuration
@@ -76,6 +125,10 @@ type RecipeSpec struct {
 	// Manual precision override
 	Precision string `json:"precision,omitempty"`
 
+	// PrecisionPreferences is an ordered fallback list used by auto-selection,
+	// e.g. ["fp8", "bf16", "fp16"]. Precision, if set, takes priority as a single-value list.
+	PrecisionPreferences []string `json:"precisionPreferences,omitempty"`
+
 	// Manual backend override
 	Backend string `json:"backend,omitempty"`
 
@@ -83,6 +136,16 @@ type RecipeSpec struct {
 	CustomRecipe *CustomRecipeRef `json:"customRecipe,omitempty"`
 }
 
+// ResolvedPrecisionPreferences returns the ordered precision fallback list to
+// use for auto-selection: the single-value Precision override if set,
+// otherwise the explicit PrecisionPreferences list.
+func (r RecipeSpec) ResolvedPrecisionPreferences() []string {
+	if r.Precision != "" {
+		return []string{r.Precision}
+	}
+	return r.PrecisionPreferences
+}
+
 // CustomRecipeRef defines a reference to a custom recipe
 type CustomRecipeRef struct {
 	// Name of the custom recipe
@@ -98,18 +161,113 @@ type ResourceSpec struct {
 	GPUCount *int32 `json:"gpuCount,omitempty"`
 
 	// Memory request
-	Memory string `json:"memory,omitempty"`
+	Memory *resource.Quantity `json:"memory,omitempty"`
 
 	// CPU request
-	CPU string `json:"cpu,omitempty"`
+	CPU *resource.Quantity `json:"cpu,omitempty"`
 
 	// Memory limit
-	MemoryLimit string `json:"memoryLimit,omitempty"`
+	MemoryLimit *resource.Quantity `json:"memoryLimit,omitempty"`
 
 	// CPU limit
-	CPULimit string `json:"cpuLimit,omitempty"`
+	CPULimit *resource.Quantity `json:"cpuLimit,omitempty"`
+
+	// Topology constrains GPU placement for tensor/pipeline-parallel workloads
+	Topology *TopologySpec `json:"topology,omitempty"`
+}
+
+// TopologySpec constrains GPU vendor/model, interconnect, NUMA affinity, and
+// MIG partitioning for a ResourceSpec or GPUConfiguration
+type TopologySpec struct {
+	// GPUModel selects a specific GPU product, e.g. MI300X, MI250
+	GPUModel string `json:"gpuModel,omitempty"`
+
+	// Interconnect is the required GPU-to-GPU link, e.g. xgmi, pcie
+	Interconnect string `json:"interconnect,omitempty"`
+
+	// MinInterconnectBandwidthGBs is the minimum required interconnect bandwidth in GB/s
+	MinInterconnectBandwidthGBs *int32 `json:"minInterconnectBandwidthGBs,omitempty"`
+
+	// NUMAPolicy is the NUMA affinity policy: single-node, preferred, or restricted
+	NUMAPolicy string `json:"numaPolicy,omitempty"`
+
+	// MIGProfile is the MIG/partition profile string, e.g. "1g.10gb"
+	MIGProfile string `json:"migProfile,omitempty"`
+}
+
+const (
+	ServingModeSingleNode = "singleNode"
+	ServingModeMultiNode  = "multiNode"
+)
+
+const (
+	MultiNodeBackendLeaderWorkerSet = "leaderworkerset"
+	MultiNodeBackendStatefulSet     = "statefulset"
+)
+
+// ServingTopologySpec selects how an endpoint's replicas are laid out across
+// nodes. singleNode (the default) is one apps/v1 Deployment, one pod per
+// replica. multiNode splits a single replica across WorkerReplicas nodes via
+// either a LeaderWorkerSet or a headless-Service-plus-StatefulSet pair,
+// chosen by Backend.
+type ServingTopologySpec struct {
+	// +kubebuilder:validation:Enum=singleNode;multiNode
+	// +kubebuilder:default=singleNode
+	Mode string `json:"mode,omitempty"`
+
+	// WorkerReplicas is the number of nodes one multiNode replica spans,
+	// including the leader. Ignored in singleNode mode. Defaults to 2.
+	WorkerReplicas *int32 `json:"workerReplicas,omitempty"`
+
+	// Backend selects the multiNode implementation: "leaderworkerset"
+	// (default) uses a LeaderWorkerSet for distinct leader/worker pod
+	// templates, "statefulset" uses a headless Service and a homogeneous
+	// StatefulSet whose entrypoint derives its role from its pod ordinal.
+	// +kubebuilder:validation:Enum=leaderworkerset;statefulset
+	Backend string `json:"backend,omitempty"`
 }
 
+// IsMultiNode reports whether t selects multi-node serving. Safe to call on
+// a nil *ServingTopologySpec.
+func (t *ServingTopologySpec) IsMultiNode() bool {
+	return t != nil && t.Mode == ServingModeMultiNode
+}
+
+// ResolvedBackend returns the multiNode implementation to use, defaulting to
+// leaderworkerset. Safe to call on a nil *ServingTopologySpec.
+func (t *ServingTopologySpec) ResolvedBackend() string {
+	if t != nil && t.Backend != "" {
+		return t.Backend
+	}
+	return MultiNodeBackendLeaderWorkerSet
+}
+
+// ResolvedWorkerReplicas returns the number of nodes one multiNode replica
+// spans, defaulting to 2. Safe to call on a nil *ServingTopologySpec.
+func (t *ServingTopologySpec) ResolvedWorkerReplicas() int32 {
+	if t != nil && t.WorkerReplicas != nil {
+		return *t.WorkerReplicas
+	}
+	return 2
+}
+
+// RequestedGPUModel returns the requested GPU product, or "" if t is nil or
+// no model was requested. Safe to call on a nil *TopologySpec.
+func (t *TopologySpec) RequestedGPUModel() string {
+	if t == nil {
+		return ""
+	}
+	return t.GPUModel
+}
+
+// Autoscaler class values for ScalingSpec.AutoscalerClass
+const (
+	AutoscalerClassHPA      = "hpa"
+	AutoscalerClassKEDA     = "keda"
+	AutoscalerClassExternal = "external"
+	AutoscalerClassNone     = "none"
+)
+
 // ScalingSpec defines the scaling configuration
 type ScalingSpec struct {
 	// Minimum number of replicas
@@ -132,6 +290,91 @@ type ScalingSpec struct {
 
 	// Scale up delay in seconds
 	ScaleUpDelay *int32 `json:"scaleUpDelay,omitempty"`
+
+	// Triggers defines inference-specific scaling signals (queue depth, TTFT,
+	// tokens/sec) in the style of a KEDA ScaledObject trigger list
+	Triggers []ScalingTrigger `json:"triggers,omitempty"`
+
+	// ScaleToZero allows the deployment to scale down to zero replicas when idle
+	ScaleToZero *bool `json:"scaleToZero,omitempty"`
+
+	// CooldownPeriod is how long to wait after the last active trigger before
+	// scaling to zero, in seconds
+	CooldownPeriod *int32 `json:"cooldownPeriod,omitempty"`
+
+	// VPA provisions a VerticalPodAutoscaler alongside (or instead of) the HPA,
+	// so CPU/memory requests can track observed usage instead of requiring an
+	// accurate guess up front
+	VPA *VPASpec `json:"vpa,omitempty"`
+
+	// AutoscalerClass selects which autoscaler backend reconciles replica count:
+	// "hpa" (default, CPU/memory utilization), "keda" (Triggers drive a KEDA
+	// ScaledObject), or "external"/"none" (the operator creates no autoscaler,
+	// leaving replica count to the user or an external controller)
+	// +kubebuilder:validation:Enum=hpa;keda;external;none
+	AutoscalerClass string `json:"autoscalerClass,omitempty"`
+
+	// Metrics adds inference-aware custom metrics to the HPA (class "hpa"),
+	// so scale-up reacts to real backpressure instead of CPU, which barely
+	// moves on GPU-bound serving workloads
+	Metrics []ScalingMetric `json:"metrics,omitempty"`
+}
+
+// Metric type values for ScalingMetric.Type
+const (
+	MetricTypeRequestQueue   = "RequestQueue"
+	MetricTypeGPUUtilization = "GPUUtilization"
+	MetricTypeTTFT           = "TTFT"
+)
+
+// ScalingMetric is a single inference-aware autoscaling signal translated into
+// an autoscalingv2.MetricSpec: RequestQueue tracks per-pod queue depth
+// (vllm:num_requests_waiting / tgi_queue_size), GPUUtilization tracks
+// amd_gpu_utilization from the amd.com/gpu-labeled node exporter, and TTFT
+// tracks p95 time-to-first-token latency against TargetMs.
+type ScalingMetric struct {
+	// Type selects the metric: RequestQueue, GPUUtilization, or TTFT
+	// +kubebuilder:validation:Enum=RequestQueue;GPUUtilization;TTFT
+	Type string `json:"type"`
+
+	// Target is the average per-pod value (queue depth) or percentage (GPU
+	// utilization) that triggers scale-up. Required for RequestQueue and GPUUtilization.
+	Target *int32 `json:"target,omitempty"`
+
+	// TargetMs is the p95 time-to-first-token latency target in milliseconds.
+	// Required for TTFT.
+	TargetMs *int32 `json:"targetMs,omitempty"`
+}
+
+// VPASpec configures an optional VerticalPodAutoscaler for the endpoint's Deployment.
+// The amd.com/gpu resource is always excluded from the managed resource list, since
+// GPU count is a scheduling decision made by recipe selection, not a VPA recommendation.
+type VPASpec struct {
+	// Enabled provisions the VerticalPodAutoscaler
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// UpdateMode controls how recommendations are applied: Auto, Initial, Off, or Recreate
+	// +kubebuilder:validation:Enum=Auto;Initial;Off;Recreate
+	UpdateMode string `json:"updateMode,omitempty"`
+}
+
+// ScalingTrigger defines a single autoscaling signal source, metric, and target
+type ScalingTrigger struct {
+	// Source of the metric: prometheus, pod, or external
+	Source string `json:"source"`
+
+	// Metric is the metric name or query, e.g. vllm_num_requests_waiting,
+	// time_to_first_token_p95_ms, tokens_per_second
+	Metric string `json:"metric"`
+
+	// Target is the metric value that triggers a scale-up
+	Target string `json:"target"`
+
+	// ActivationTarget is the threshold used to scale from zero
+	ActivationTarget string `json:"activationTarget,omitempty"`
+
+	// ServerAddress is the Prometheus (or other source) endpoint to query
+	ServerAddress string `json:"serverAddress,omitempty"`
 }
 
 // ServiceSpec defines the service configuration
@@ -194,10 +437,21 @@ type CacheSpec struct {
 	StorageClass string `json:"storageClass,omitempty"`
 
 	// Cache size
-	Size string `json:"size,omitempty"`
+	Size *resource.Quantity `json:"size,omitempty"`
 
 	// Access mode
 	AccessMode string `json:"accessMode,omitempty"`
+
+	// CacheRef references a shared AIMModelCache so multiple endpoints can warm-start
+	// against one pre-populated cache instead of each provisioning its own
+	CacheRef *LocalObjectReference `json:"cacheRef,omitempty"`
+
+	// AIMCacheNames lists the AIMCaches (in the endpoint's own namespace) this
+	// endpoint depends on for model caching. An AIMCacheBinding is created for
+	// each one that currently reports Model.ID as cached, so AIMCache deletion
+	// protection reflects a real, explicit dependency instead of every cache
+	// that coincidentally has the same model ID cached.
+	AIMCacheNames []string `json:"aimCacheNames,omitempty"`
 }
 
 // SecuritySpec defines the security configuration
@@ -257,7 +511,9 @@ type RollingUpdateSpec struct {
 	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
 }
 
-// CanarySpec defines canary deployment configuration
+// CanarySpec defines canary deployment configuration for a single AIMEndpoint.
+// For multi-backend A/B testing and header-based cohorting across endpoints,
+// see AIMEndpointRoute, which supersedes this for progressive rollout scenarios.
 type CanarySpec struct {
 	// Enable canary deployment
 	Enabled *bool `json:"enabled,omitempty"`
@@ -298,6 +554,11 @@ type AIMEndpointStatus struct {
 	// Selected recipe information
 	SelectedRecipe *SelectedRecipeStatus `json:"selectedRecipe,omitempty"`
 
+	// RecipeSelection records the full scoring decision behind SelectedRecipe
+	// (candidates considered, rejection reasons, winning score), for
+	// debugging why auto-selection picked what it picked
+	RecipeSelection *RecipeSelectionStatus `json:"recipeSelection,omitempty"`
+
 	// Service endpoints
 	Endpoints *EndpointStatus `json:"endpoints,omitempty"`
 
@@ -321,6 +582,47 @@ type SelectedRecipeStatus struct {
 
 	// Selected backend
 	Backend string `json:"backend,omitempty"`
+
+	// Topology actually selected for this endpoint's replicas
+	Topology *TopologyStatus `json:"topology,omitempty"`
+}
+
+// RecipeSelectionStatus mirrors pkg/recipeselect.Explanation on the wire, so
+// `kubectl describe aimendpoint` can show why a recipe was (or wasn't)
+// selected without a user needing controller logs.
+type RecipeSelectionStatus struct {
+	// Selected is the name of the chosen recipe, empty if none matched
+	Selected string `json:"selected,omitempty"`
+
+	// Score is the winning recipe's score
+	Score int `json:"score,omitempty"`
+
+	// Reasons explains why the winning recipe scored as it did
+	Reasons []string `json:"reasons,omitempty"`
+
+	// Rejected lists candidates that were considered but not chosen, and why
+	Rejected []RejectedRecipeStatus `json:"rejected,omitempty"`
+}
+
+// RejectedRecipeStatus records a recipe that was considered but passed over
+type RejectedRecipeStatus struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// TopologyStatus reports the GPU topology actually chosen for a replica
+type TopologyStatus struct {
+	// GPUModel is the GPU product actually scheduled, e.g. MI300X
+	GPUModel string `json:"gpuModel,omitempty"`
+
+	// Interconnect is the interconnect actually available on the chosen node
+	Interconnect string `json:"interconnect,omitempty"`
+
+	// NUMAPolicy is the NUMA affinity policy applied
+	NUMAPolicy string `json:"numaPolicy,omitempty"`
+
+	// MIGProfile is the MIG/partition profile applied, if any
+	MIGProfile string `json:"migProfile,omitempty"`
 }
 
 // EndpointStatus defines service endpoints
@@ -366,6 +668,18 @@ type MetricsStatus struct {
 
 	// CPU utilization percentage
 	CPUUtilization *float64 `json:"cpuUtilization,omitempty"`
+
+	// Per-stage resource usage for the predictor graph (transformer/predictor/explainer)
+	Stages map[string]*StageMetrics `json:"stages,omitempty"`
+}
+
+// StageMetrics defines resource usage for a single predictor graph stage
+type StageMetrics struct {
+	// CPU utilization percentage
+	CPUUtilization *float64 `json:"cpuUtilization,omitempty"`
+
+	// Memory utilization percentage
+	MemoryUtilization *float64 `json:"memoryUtilization,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -396,4 +710,4 @@ type AIMEndpointList struct {
 
 func init() {
 	SchemeBuilder.Register(&AIMEndpoint{}, &AIMEndpointList{})
-} 
\ No newline at end of file
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+//+kubebuilder:webhook:path=/validate-aim-engine-amd-com-v1alpha1-aimendpoint,mutating=false,failurePolicy=fail,sideEffects=None,groups=aim.engine.amd.com,resources=aimendpoints,verbs=create;update,versions=v1alpha1,name=vaimendpoint.kb.io,admissionReviewVersions=v1
+
+// AIMEndpointCustomValidator validates Resources.Memory/CPU against their limits.
+// The per-configuration check against PerformanceSpec.MaxBatchSize is
+// ValidateConfigurationBatchSize, which this validator does not call.
+type AIMEndpointCustomValidator struct{}
+
+var _ admission.CustomValidator = &AIMEndpointCustomValidator{}
+
+// SetupWebhookWithManager registers the validating webhook with the manager
+func (v *AIMEndpointCustomValidator) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&AIMEndpoint{}).
+		WithValidator(v).
+		Complete()
+}
+
+// ValidateCreate implements admission.CustomValidator
+func (v *AIMEndpointCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(obj.(*AIMEndpoint))
+}
+
+// ValidateUpdate implements admission.CustomValidator
+func (v *AIMEndpointCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(newObj.(*AIMEndpoint))
+}
+
+// ValidateDelete implements admission.CustomValidator
+func (v *AIMEndpointCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *AIMEndpointCustomValidator) validate(endpoint *AIMEndpoint) error {
+	if err := validateResourceSpec(endpoint.Spec.Resources); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateResourceSpec rejects a ResourceSpec whose requests exceed its limits.
+// Memory/CPU Quantity fields round-trip to admission-time validated values, so a
+// malformed quantity is already rejected by the API server's OpenAPI schema before
+// this runs.
+func validateResourceSpec(resources ResourceSpec) error {
+	if resources.CPU != nil && resources.CPULimit != nil && !resources.CPULimit.IsZero() && resources.CPU.Cmp(*resources.CPULimit) > 0 {
+		return fmt.Errorf("resources.cpu (%s) exceeds resources.cpuLimit (%s)", resources.CPU.String(), resources.CPULimit.String())
+	}
+	if resources.Memory != nil && resources.MemoryLimit != nil && !resources.MemoryLimit.IsZero() && resources.Memory.Cmp(*resources.MemoryLimit) > 0 {
+		return fmt.Errorf("resources.memory (%s) exceeds resources.memoryLimit (%s)", resources.Memory.String(), resources.MemoryLimit.String())
+	}
+	return nil
+}
+
+// ValidateConfigurationBatchSize rejects a GPUConfiguration whose memory request is
+// below 256Mi per unit of the recipe's declared MaxBatchSize, a common source of
+// OOM-killed replicas when users size requests for a smaller batch than the recipe
+// actually serves. Other resource requests are not checked.
+func ValidateConfigurationBatchSize(config GPUConfiguration, performance *PerformanceSpec) error {
+	if performance == nil || performance.MaxBatchSize == nil || config.Resources == nil {
+		return nil
+	}
+
+	memRequest, ok := config.Resources.Requests["memory"]
+	if !ok || *performance.MaxBatchSize <= 0 {
+		return nil
+	}
+
+	// Heuristic floor: at least 256Mi of memory per unit of batch size.
+	minPerBatchUnit := int64(256 * 1024 * 1024)
+	if memRequest.Value()/int64(*performance.MaxBatchSize) < minPerBatchUnit {
+		return fmt.Errorf("resources.requests.memory is too small for performance.maxBatchSize=%d", *performance.MaxBatchSize)
+	}
+
+	return nil
+}
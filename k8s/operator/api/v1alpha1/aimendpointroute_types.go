@@ -0,0 +1,160 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AIMEndpointRouteSpec defines the desired state of AIMEndpointRoute
+type AIMEndpointRouteSpec struct {
+	// Backends lists the AIMEndpoint targets this route dispatches to
+	Backends []RouteBackend `json:"backends"`
+
+	// Rollout describes a progressive rollout across the backends, superseding
+	// the single-value DeploymentSpec.Canary for multi-backend routes
+	Rollout *RouteRolloutSpec `json:"rollout,omitempty"`
+}
+
+// RouteBackend defines a single weighted backend target for an AIMEndpointRoute
+type RouteBackend struct {
+	// EndpointRef references the target AIMEndpoint
+	EndpointRef LocalObjectReference `json:"endpointRef"`
+
+	// Weight is the percentage of unmatched traffic sent to this backend
+	Weight *int32 `json:"weight,omitempty"`
+
+	// Match restricts this backend to requests meeting the given criteria
+	Match *RouteMatch `json:"match,omitempty"`
+
+	// Shadow mirrors a copy of matched traffic to this backend for offline evaluation
+	Shadow *RouteShadow `json:"shadow,omitempty"`
+}
+
+// RouteMatch defines request matching rules for a RouteBackend
+type RouteMatch struct {
+	// Header matches an HTTP request header name/value pair
+	Header *HeaderMatch `json:"header,omitempty"`
+
+	// PathPrefix matches the request path prefix
+	PathPrefix string `json:"pathPrefix,omitempty"`
+
+	// ModelName matches the "model" field of the JSON request body
+	ModelName string `json:"modelName,omitempty"`
+}
+
+// HeaderMatch matches an HTTP header
+type HeaderMatch struct {
+	// Header name
+	Name string `json:"name"`
+
+	// Header value
+	Value string `json:"value"`
+}
+
+// RouteShadow defines a shadow/mirror target for offline evaluation
+type RouteShadow struct {
+	// EndpointRef references the AIMEndpoint to mirror traffic to
+	EndpointRef LocalObjectReference `json:"endpointRef"`
+
+	// Percent of matched traffic to mirror
+	Percent *int32 `json:"percent,omitempty"`
+}
+
+// RouteRolloutSpec defines a progressive rollout across route backends
+type RouteRolloutSpec struct {
+	// Steps are the ordered progressive weight steps of the rollout
+	Steps []RolloutStep `json:"steps,omitempty"`
+
+	// SuccessCriteria gates advancement to the next step
+	SuccessCriteria *RolloutSuccessCriteria `json:"successCriteria,omitempty"`
+}
+
+// RolloutStep defines a single step of a progressive rollout
+type RolloutStep struct {
+	// TargetWeight is the weight the target backend should reach at this step
+	TargetWeight int32 `json:"targetWeight"`
+
+	// DwellTime is how long to hold this step before evaluating success criteria
+	DwellTime string `json:"dwellTime,omitempty"`
+}
+
+// RolloutSuccessCriteria defines the metrics-based gate for advancing a rollout
+type RolloutSuccessCriteria struct {
+	// MaxLatencyMs is the maximum acceptable MetricsStatus.Latency during dwell time
+	MaxLatencyMs *float64 `json:"maxLatencyMs,omitempty"`
+
+	// MaxErrorRate is the maximum acceptable error rate (0-1) during dwell time
+	MaxErrorRate *float64 `json:"maxErrorRate,omitempty"`
+}
+
+// AIMEndpointRouteStatus defines the observed state of AIMEndpointRoute
+type AIMEndpointRouteStatus struct {
+	// Conditions represent the latest available observations of an object's state
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the last observed generation
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Current phase of the route
+	Phase string `json:"phase,omitempty"`
+
+	// ObservedSplit reports the actual traffic split observed per backend endpoint name
+	ObservedSplit map[string]int32 `json:"observedSplit,omitempty"`
+
+	// RolloutPhase reports progress through Spec.Rollout.Steps
+	RolloutPhase *RouteRolloutStatus `json:"rolloutPhase,omitempty"`
+}
+
+// RouteRolloutStatus reports the current step of an in-progress rollout
+type RouteRolloutStatus struct {
+	// CurrentStep is the index into Spec.Rollout.Steps currently active
+	CurrentStep int32 `json:"currentStep,omitempty"`
+
+	// CurrentWeight is the weight currently being served to the target backend
+	CurrentWeight int32 `json:"currentWeight,omitempty"`
+
+	// StepStartedAt is when the current step began dwelling
+	StepStartedAt *metav1.Time `json:"stepStartedAt,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// AIMEndpointRoute is the Schema for the aimendpointroutes API
+type AIMEndpointRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AIMEndpointRouteSpec   `json:"spec,omitempty"`
+	Status AIMEndpointRouteStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AIMEndpointRouteList contains a list of AIMEndpointRoute
+type AIMEndpointRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AIMEndpointRoute `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AIMEndpointRoute{}, &AIMEndpointRouteList{})
+}
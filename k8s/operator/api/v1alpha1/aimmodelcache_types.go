@@ -0,0 +1,89 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AIMModelCacheSpec defines the desired state of AIMModelCache. An AIMModelCache
+// pre-downloads and verifies a single model into a shared ReadWriteMany PVC so
+// multiple AIMEndpoints can warm-start against it instead of each replica
+// re-fetching multi-GB weights into its own ephemeral cache.
+type AIMModelCacheSpec struct {
+	// Model to warm up
+	Model ModelSpec `json:"model"`
+
+	// Storage configuration for the shared cache
+	Storage StorageSpec `json:"storage"`
+}
+
+// AIMModelCacheStatus defines the observed state of AIMModelCache
+type AIMModelCacheStatus struct {
+	// Conditions represent the latest available observations of an object's state
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the last observed generation
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Current phase of the model cache
+	Phase string `json:"phase,omitempty"`
+
+	// Download progress as a percentage (0-100)
+	DownloadProgress *float64 `json:"downloadProgress,omitempty"`
+
+	// SHA256 checksum of the downloaded model
+	SHA256 string `json:"sha256,omitempty"`
+
+	// On-disk size of the downloaded model
+	Size string `json:"size,omitempty"`
+
+	// Last time the cache contents were refreshed
+	LastRefresh *metav1.Time `json:"lastRefresh,omitempty"`
+
+	// Usage statistics shared with garbage collection
+	Usage *UsageStatus `json:"usage,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Model",type="string",JSONPath=".spec.model.id"
+//+kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+//+kubebuilder:printcolumn:name="Progress",type="string",JSONPath=".status.downloadProgress"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// AIMModelCache is the Schema for the aimmodelcaches API
+type AIMModelCache struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AIMModelCacheSpec   `json:"spec,omitempty"`
+	Status AIMModelCacheStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AIMModelCacheList contains a list of AIMModelCache
+type AIMModelCacheList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AIMModelCache `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AIMModelCache{}, &AIMModelCacheList{})
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -42,6 +43,22 @@ type AIMRecipeSpec struct {
 
 	// Performance expectations
 	Performance *PerformanceSpec `json:"performance,omitempty"`
+
+	// Disruption controls how drifted endpoints are rolled out to a new recipe digest
+	Disruption *DisruptionSpec `json:"disruption,omitempty"`
+}
+
+// DisruptionSpec defines the opt-in disruption policy for drifted endpoints
+type DisruptionSpec struct {
+	// Policy controls when drifted endpoints may be disrupted: WhenEmpty, WhenIdle, or Never
+	Policy string `json:"policy,omitempty"`
+
+	// ConsolidateAfter is a grace period, e.g. "10m". AIMRecipeDisruptionReconciler does not
+	// read it: an eligible drifted endpoint is marked on the first pass that finds it.
+	ConsolidateAfter string `json:"consolidateAfter,omitempty"`
+
+	// MaxDisruptedEndpoints caps how many endpoints may be marked for rollout at once
+	MaxDisruptedEndpoints *int32 `json:"maxDisruptedEndpoints,omitempty"`
 }
 
 // GPUConfiguration defines a GPU configuration
@@ -60,6 +77,9 @@ type GPUConfiguration struct {
 
 	// Resource requirements
 	Resources *ResourceRequirements `json:"resources,omitempty"`
+
+	// Topology constrains GPU vendor/model, interconnect, and NUMA affinity for this configuration
+	Topology *TopologySpec `json:"topology,omitempty"`
 }
 
 // EnvVar represents an environment variable
@@ -143,10 +163,10 @@ type LocalObjectReference struct {
 // ResourceRequirements describes the compute resource requirements
 type ResourceRequirements struct {
 	// Limits describes the maximum amount of compute resources allowed
-	Limits map[string]string `json:"limits,omitempty"`
+	Limits map[string]resource.Quantity `json:"limits,omitempty"`
 
 	// Requests describes the minimum amount of compute resources required
-	Requests map[string]string `json:"requests,omitempty"`
+	Requests map[string]resource.Quantity `json:"requests,omitempty"`
 }
 
 // PerformanceSpec defines performance expectations
@@ -174,6 +194,22 @@ type AIMRecipeStatus struct {
 
 	// Usage statistics
 	Usage *UsageStatus `json:"usage,omitempty"`
+
+	// SpecHash is a content hash over the runtime-affecting spec fields
+	// (Hardware, Precision, Backend, and the GPU counts of enabled Configurations).
+	// Container image digests are not part of the hash.
+	SpecHash string `json:"specHash,omitempty"`
+
+	// Drift maps endpoint "namespace/name" to the SpecHash it last selected,
+	// used to detect when a live endpoint has fallen behind this recipe
+	Drift map[string]string `json:"drift,omitempty"`
+
+	// Compatibility lists the hardware platforms this recipe can satisfy,
+	// including family-compatible platforms beyond Spec.Hardware itself
+	// (e.g. a recipe authored for MI300X also lists MI325X and MI355X), so an
+	// endpoint's requested GPU model can be checked against a recipe without
+	// re-deriving hardware family membership outside pkg/recipeselect.
+	Compatibility []string `json:"compatibility,omitempty"`
 }
 
 // UsageStatus defines usage statistics
@@ -214,4 +250,4 @@ type AIMRecipeList struct {
 
 func init() {
 	SchemeBuilder.Register(&AIMRecipe{}, &AIMRecipeList{})
-} 
\ No newline at end of file
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Valid-values lists shared by the webhook and the reconciler's lightweight
+// revalidation pass, so there is one source of truth for what a recipe may
+// declare. The CRD schema carries no OpenAPI enum for these fields, so a value
+// outside them is caught only by the webhook or the revalidation pass.
+var (
+	ValidHardwarePlatforms = []string{"MI300X", "MI325X", "MI355X", "MI250", "MI210"}
+	ValidPrecisions        = []string{"bfloat16", "float16", "float8", "int8", "int4"}
+	ValidBackends          = []string{"vllm", "sglang"}
+)
+
+// DefaultBackend is the backend a recipe receives when Spec.Backend is unset
+const DefaultBackend = "vllm"
+
+//+kubebuilder:webhook:path=/validate-aim-engine-amd-com-v1alpha1-aimrecipe,mutating=false,failurePolicy=fail,sideEffects=None,groups=aim.engine.amd.com,resources=aimrecipes,verbs=create;update,versions=v1alpha1,name=vaimrecipe.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/mutate-aim-engine-amd-com-v1alpha1-aimrecipe,mutating=true,failurePolicy=fail,sideEffects=None,groups=aim.engine.amd.com,resources=aimrecipes,verbs=create;update,versions=v1alpha1,name=maimrecipe.kb.io,admissionReviewVersions=v1
+
+// AIMRecipeCustomValidator rejects invalid recipes at kubectl apply time instead
+// of letting them land in etcd with Status.Phase=Invalid
+type AIMRecipeCustomValidator struct{}
+
+var _ admission.CustomValidator = &AIMRecipeCustomValidator{}
+
+// AIMRecipeCustomDefaulter fills in sensible defaults for an AIMRecipe
+type AIMRecipeCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &AIMRecipeCustomDefaulter{}
+
+// SetupAIMRecipeWebhookWithManager registers the validating and defaulting webhooks with the manager
+func SetupAIMRecipeWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&AIMRecipe{}).
+		WithValidator(&AIMRecipeCustomValidator{}).
+		WithDefaulter(&AIMRecipeCustomDefaulter{}).
+		Complete()
+}
+
+// ValidateCreate implements admission.CustomValidator
+func (v *AIMRecipeCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, ValidateRecipeSpec(obj.(*AIMRecipe))
+}
+
+// ValidateUpdate implements admission.CustomValidator
+func (v *AIMRecipeCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, ValidateRecipeSpec(newObj.(*AIMRecipe))
+}
+
+// ValidateDelete implements admission.CustomValidator
+func (v *AIMRecipeCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// Default implements admission.CustomDefaulter
+func (d *AIMRecipeCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	recipe := obj.(*AIMRecipe)
+
+	if recipe.Spec.Backend == "" {
+		recipe.Spec.Backend = DefaultBackend
+	}
+
+	if len(recipe.Spec.Configurations) > 0 {
+		hasEnabled := false
+		for _, config := range recipe.Spec.Configurations {
+			if config.Enabled {
+				hasEnabled = true
+				break
+			}
+		}
+		if !hasEnabled {
+			recipe.Spec.Configurations[0].Enabled = true
+		}
+	}
+
+	return nil
+}
+
+// ValidateRecipeSpec is the shared validation entry point used by both the
+// admission webhook and the reconciler's lightweight revalidation pass (for
+// recipes that land in etcd with the webhook bypassed, e.g. during upgrades).
+func ValidateRecipeSpec(recipe *AIMRecipe) error {
+	if !containsValue(ValidHardwarePlatforms, recipe.Spec.Hardware) {
+		return fmt.Errorf("invalid hardware platform: %s", recipe.Spec.Hardware)
+	}
+
+	if !containsValue(ValidPrecisions, recipe.Spec.Precision) {
+		return fmt.Errorf("invalid precision: %s", recipe.Spec.Precision)
+	}
+
+	if !containsValue(ValidBackends, recipe.Spec.Backend) {
+		return fmt.Errorf("invalid backend: %s", recipe.Spec.Backend)
+	}
+
+	if len(recipe.Spec.Configurations) == 0 {
+		return fmt.Errorf("at least one GPU configuration is required")
+	}
+
+	enabledConfigs := 0
+	for i, config := range recipe.Spec.Configurations {
+		if config.GPUCount < 1 || config.GPUCount > 8 {
+			return fmt.Errorf("configuration %d: GPU count must be between 1 and 8", i)
+		}
+		if config.Enabled {
+			enabledConfigs++
+		}
+		if err := ValidateConfigurationBatchSize(config, recipe.Spec.Performance); err != nil {
+			return fmt.Errorf("configuration %d: %v", i, err)
+		}
+	}
+
+	if enabledConfigs == 0 {
+		return fmt.Errorf("at least one GPU configuration must be enabled")
+	}
+
+	return nil
+}
+
+func containsValue(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
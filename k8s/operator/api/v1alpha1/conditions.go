@@ -0,0 +1,60 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// Condition types shared across the v1alpha1 API, following the standard
+// Kubernetes condition schema (KEP-1623). Consumers should react to these
+// typed constants rather than comparing raw strings.
+const (
+	// ConditionValid indicates whether a recipe's spec passed validation
+	ConditionValid = "Valid"
+
+	// ConditionReady indicates the resource has finished reconciling and is serving
+	ConditionReady = "Ready"
+
+	// ConditionDeletionBlocked indicates deletion is held because the resource is still referenced
+	ConditionDeletionBlocked = "DeletionBlocked"
+
+	// ConditionDrifted indicates the live resource has diverged from its resolved spec hash
+	ConditionDrifted = "Drifted"
+
+	// ConditionProgressing indicates an owned resource (Deployment, PVC, Pods, ...) has
+	// not yet reached its desired state, in the style of kstatus's InProgress status
+	ConditionProgressing = "Progressing"
+
+	// ConditionAvailable indicates the Deployment has the desired number of available,
+	// up-to-date replicas, per Helm 3.5+'s Deployment readiness rules
+	ConditionAvailable = "Available"
+
+	// ConditionResourcesReady indicates the workload (Deployment, StatefulSet or
+	// LeaderWorkerSet), Service, the endpoint's own cache PVC and its Pods have all
+	// reached their kstatus-equivalent Current status; an HPA or ConfigMap is not checked
+	ConditionResourcesReady = "ResourcesReady"
+
+	// ConditionModelLoaded indicates the endpoint's pods have finished loading the
+	// model weights and are serving traffic
+	ConditionModelLoaded = "ModelLoaded"
+)
+
+// Condition reasons shared across the v1alpha1 API
+const (
+	ReasonValidationSucceeded = "ValidationSucceeded"
+	ReasonValidationFailed    = "ValidationFailed"
+	ReasonReconcileSucceeded  = "ReconcileSucceeded"
+	ReasonStillReferenced     = "StillReferenced"
+	ReasonSpecHashChanged     = "SpecHashChanged"
+)
@@ -18,9 +18,15 @@ package controllers
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/robfig/cron/v3"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,20 +37,88 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	aimv1alpha1 "github.com/aim-engine/operator/api/v1alpha1"
+	"github.com/aim-engine/operator/pkg/cache/assume"
+	"github.com/aim-engine/operator/pkg/cache/evict"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 )
 
+// agentJobRecheckInterval bounds how often a ReadWriteOnce cache's one-shot
+// measurement Job is re-run: the volume can only be mounted by one node at a
+// time, so there's no benefit to re-measuring on every 15-minute reconcile.
+const agentJobRecheckInterval = time.Hour
+
+// aimCacheAgentImage is the aim-cache-agent image deployed onto (or
+// dispatched as a Job against) the nodes backing a cache's storage.
+const aimCacheAgentImage = "ghcr.io/aim-engine/aim-cache-agent:latest"
+
+// defaultPullerImage is the image preload Jobs run when
+// AIMCacheReconciler.PullerImage is unset.
+const defaultPullerImage = "ghcr.io/aim-engine/aim-model-puller:latest"
+
+// defaultMaxConcurrentPreloads bounds how many preload Jobs run at once per
+// cache when AIMCacheReconciler.MaxConcurrentPreloads is unset or <= 0.
+const defaultMaxConcurrentPreloads = 2
+
+// preloadProgressAnnotation is an annotation the puller image patches onto
+// its own Job with its current download percentage, since a running Job's
+// built-in status has no room for application-defined progress.
+const preloadProgressAnnotation = "aim.engine.amd.com/download-progress"
+
+// tierMigrationInterval bounds how often the tier-migration pass re-scans
+// every cached model's access pattern and re-dispatches movers.
+const tierMigrationInterval = 30 * time.Minute
+
 // AIMCacheReconciler reconciles a AIMCache object
 type AIMCacheReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// models tracks the status of each cache's models between reconciles,
+	// so a model a reconcile is about to start caching reads back as
+	// "pending" for any other reconcile that references the same AIMCache
+	// before the real download finishes and the status round-trips through
+	// the API server. Lazily initialized by SetupWithManager.
+	models *assume.Cache
+
+	// PullerImage overrides the image preload Jobs run to fetch Preload=true
+	// models, configurable via the manager's --model-puller-image flag.
+	// Defaults to defaultPullerImage when unset.
+	PullerImage string
+
+	// MaxConcurrentPreloads bounds how many preload Jobs run at once per
+	// cache, configurable via the manager's --max-concurrent-preloads flag.
+	// Defaults to defaultMaxConcurrentPreloads when unset or <= 0.
+	MaxConcurrentPreloads int
+}
+
+// pullerImage returns r.PullerImage, or defaultPullerImage if unset.
+func (r *AIMCacheReconciler) pullerImage() string {
+	if r.PullerImage != "" {
+		return r.PullerImage
+	}
+	return defaultPullerImage
+}
+
+// maxConcurrentPreloads returns r.MaxConcurrentPreloads, or
+// defaultMaxConcurrentPreloads if unset or non-positive.
+func (r *AIMCacheReconciler) maxConcurrentPreloads() int {
+	if r.MaxConcurrentPreloads > 0 {
+		return r.MaxConcurrentPreloads
+	}
+	return defaultMaxConcurrentPreloads
 }
 
 //+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimcaches,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimcaches/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimcaches/finalizers,verbs=update
+//+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimcachereports,verbs=get;list;watch
+//+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimcachebindings,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+//+kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -79,12 +153,24 @@ func (r *AIMCacheReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		}
 	}
 
-	// Create or update PVC
-	if err := r.reconcilePVC(ctx, aimCache); err != nil {
+	// Create or update the cache's PVC(s): one per tier if Storage.Tiers is
+	// set, otherwise the original single PVC.
+	if len(aimCache.Spec.Storage.Tiers) > 0 {
+		if err := r.reconcileTierPVCs(ctx, aimCache); err != nil {
+			logger.Error(err, "Failed to reconcile tier PVCs")
+			return ctrl.Result{}, err
+		}
+	} else if err := r.reconcilePVC(ctx, aimCache); err != nil {
 		logger.Error(err, "Failed to reconcile PVC")
 		return ctrl.Result{}, err
 	}
 
+	// Deploy the aim-cache-agent that measures real usage on this PVC
+	if err := r.reconcileAgent(ctx, aimCache); err != nil {
+		logger.Error(err, "Failed to reconcile cache agent")
+		return ctrl.Result{}, err
+	}
+
 	// Update storage status
 	if err := r.updateStorageStatus(ctx, aimCache); err != nil {
 		logger.Error(err, "Failed to update storage status")
@@ -97,6 +183,24 @@ func (r *AIMCacheReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return ctrl.Result{}, err
 	}
 
+	// Dispatch preload Jobs for Preload=true models not yet cached
+	if err := r.reconcilePreload(ctx, aimCache); err != nil {
+		logger.Error(err, "Failed to reconcile model preload")
+		return ctrl.Result{}, err
+	}
+
+	// Refresh who actually depends on this cache
+	if err := r.updateReferencedBy(ctx, aimCache); err != nil {
+		logger.Error(err, "Failed to update referenced-by status")
+		return ctrl.Result{}, err
+	}
+
+	// Migrate cached models between storage tiers as their access patterns change
+	if err := r.reconcileTierMigration(ctx, aimCache); err != nil {
+		logger.Error(err, "Failed to reconcile tier migration")
+		return ctrl.Result{}, err
+	}
+
 	// Run cleanup if enabled
 	if aimCache.Spec.Cleanup != nil && aimCache.Spec.Cleanup.Enabled != nil && *aimCache.Spec.Cleanup.Enabled {
 		if err := r.runCleanup(ctx, aimCache); err != nil {
@@ -129,9 +233,15 @@ func (r *AIMCacheReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *AIMCacheReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.models == nil {
+		r.models = assume.NewCache()
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&aimv1alpha1.AIMCache{}).
 		Owns(&corev1.PersistentVolumeClaim{}).
+		Owns(&appsv1.DaemonSet{}).
+		Owns(&batchv1.Job{}).
 		Complete(r)
 }
 
@@ -181,8 +291,354 @@ func (r *AIMCacheReconciler) reconcilePVC(ctx context.Context, cache *aimv1alpha
 	return err
 }
 
+// tierPVCName is the PVC backing one tier of cache.
+func tierPVCName(cache *aimv1alpha1.AIMCache, tierName string) string {
+	return fmt.Sprintf("%s-storage-%s", cache.Name, tierName)
+}
+
+// tierMountPath is where a tier's PVC is mounted under the cache's shared
+// mountPath, so the agent/puller/migration images can tell tiers apart on disk.
+func tierMountPath(cache *aimv1alpha1.AIMCache, tierName string) string {
+	return fmt.Sprintf("%s/%s", mountPath(cache), tierName)
+}
+
+// tierNames returns tiers' names in order, hottest first.
+func tierNames(tiers []aimv1alpha1.StorageTierSpec) []string {
+	names := make([]string, len(tiers))
+	for i, tier := range tiers {
+		names[i] = tier.Name
+	}
+	return names
+}
+
+// reconcileTierPVCs creates or updates one PersistentVolumeClaim per
+// configured storage tier, in place of reconcilePVC's single PVC.
+func (r *AIMCacheReconciler) reconcileTierPVCs(ctx context.Context, cache *aimv1alpha1.AIMCache) error {
+	for _, tier := range cache.Spec.Storage.Tiers {
+		tier := tier
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      tierPVCName(cache, tier.Name),
+				Namespace: cache.Namespace,
+			},
+		}
+
+		_, err := ctrl.CreateOrUpdate(ctx, r.Client, pvc, func() error {
+			pvc.Labels = map[string]string{
+				"app.kubernetes.io/name":      "aim-cache",
+				"app.kubernetes.io/instance":  cache.Name,
+				"app.kubernetes.io/component": "storage",
+				"aim.engine.amd.com/tier":     tier.Name,
+			}
+
+			pvc.OwnerReferences = []metav1.OwnerReference{
+				*metav1.NewControllerRef(cache, aimv1alpha1.GroupVersion.WithKind("AIMCache")),
+			}
+
+			accessMode := corev1.ReadWriteOnce
+			if cache.Spec.Storage.AccessMode != "" {
+				accessMode = corev1.PersistentVolumeAccessMode(cache.Spec.Storage.AccessMode)
+			}
+
+			pvc.Spec = corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{accessMode},
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: resource.MustParse(tier.Size),
+					},
+				},
+			}
+
+			if tier.StorageClass != "" {
+				pvc.Spec.StorageClassName = &tier.StorageClass
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("tier %s: %w", tier.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// agentName is the name shared by the DaemonSet or Job running aim-cache-agent
+// for cache, and the label selector its pods carry.
+func agentName(cache *aimv1alpha1.AIMCache) string {
+	return fmt.Sprintf("%s-agent", cache.Name)
+}
+
+// agentLabels are applied to the agent DaemonSet/Job and its pod template.
+func agentLabels(cache *aimv1alpha1.AIMCache) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":      "aim-cache-agent",
+		"app.kubernetes.io/instance":  cache.Name,
+		"app.kubernetes.io/component": "cache-agent",
+	}
+}
+
+// mountPath is where the cache PVC -- and so the agent -- expects to find the
+// cached models, defaulting the same way reconcilePVC's consumers do.
+func mountPath(cache *aimv1alpha1.AIMCache) string {
+	if cache.Spec.Storage.MountPath != "" {
+		return cache.Spec.Storage.MountPath
+	}
+	return "/cache"
+}
+
+// cacheVolumes returns the PVC volume(s) and matching VolumeMount(s) the
+// agent, puller, eviction, and migration pods all mount: a single "cache"
+// volume at mountPath(cache) when Storage.Tiers is unset, or one
+// "tier-<name>" volume per tier at tierMountPath(cache, name) when it's set.
+func cacheVolumes(cache *aimv1alpha1.AIMCache) ([]corev1.Volume, []corev1.VolumeMount) {
+	if len(cache.Spec.Storage.Tiers) == 0 {
+		return []corev1.Volume{
+				{
+					Name: "cache",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: fmt.Sprintf("%s-storage", cache.Name),
+						},
+					},
+				},
+			}, []corev1.VolumeMount{
+				{Name: "cache", MountPath: mountPath(cache), ReadOnly: true},
+			}
+	}
+
+	volumes := make([]corev1.Volume, 0, len(cache.Spec.Storage.Tiers))
+	mounts := make([]corev1.VolumeMount, 0, len(cache.Spec.Storage.Tiers))
+	for _, tier := range cache.Spec.Storage.Tiers {
+		name := fmt.Sprintf("tier-%s", tier.Name)
+		volumes = append(volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: tierPVCName(cache, tier.Name),
+				},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: tierMountPath(cache, tier.Name),
+			ReadOnly:  true,
+		})
+	}
+	return volumes, mounts
+}
+
+// agentPodSpec is the Pod template shared by the DaemonSet and Job variants
+// of the agent; only RestartPolicy differs between them.
+func agentPodSpec(cache *aimv1alpha1.AIMCache, restartPolicy corev1.RestartPolicy) corev1.PodSpec {
+	volumes, mounts := cacheVolumes(cache)
+	return corev1.PodSpec{
+		ServiceAccountName: "aim-cache-agent",
+		RestartPolicy:      restartPolicy,
+		Containers: []corev1.Container{
+			{
+				Name:  "agent",
+				Image: aimCacheAgentImage,
+				Env: []corev1.EnvVar{
+					{Name: "AIM_CACHE_NAME", Value: cache.Name},
+					{Name: "AIM_CACHE_NAMESPACE", Value: cache.Namespace},
+					{Name: "AIM_CACHE_MOUNT_PATH", Value: mountPath(cache)},
+					{Name: "NODE_NAME", ValueFrom: &corev1.EnvVarSource{
+						FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"},
+					}},
+				},
+				VolumeMounts: mounts,
+			},
+		},
+		Volumes: volumes,
+	}
+}
+
+// reconcileAgent deploys aim-cache-agent to measure cache.Status.Storage and
+// CachedModels from the real PVC contents. A ReadWriteMany/ReadOnlyMany
+// volume is mountable from every node at once, so a DaemonSet runs
+// continuously and elects a leader (via its own Lease, outside this
+// controller) among replicas sharing the mount. A ReadWriteOnce volume can
+// only be attached to one node at a time -- often none, between downloads --
+// so a long-running DaemonSet would mostly sit unable to mount it; a one-shot
+// Job is dispatched instead, landing wherever the PV's attachment allows.
+func (r *AIMCacheReconciler) reconcileAgent(ctx context.Context, cache *aimv1alpha1.AIMCache) error {
+	accessMode := corev1.ReadWriteOnce
+	if cache.Spec.Storage.AccessMode != "" {
+		accessMode = corev1.PersistentVolumeAccessMode(cache.Spec.Storage.AccessMode)
+	}
+
+	if accessMode == corev1.ReadWriteMany || accessMode == corev1.ReadOnlyMany {
+		if err := r.cleanupAgentJob(ctx, cache); err != nil {
+			return err
+		}
+		return r.reconcileAgentDaemonSet(ctx, cache)
+	}
+
+	if err := r.cleanupAgentDaemonSet(ctx, cache); err != nil {
+		return err
+	}
+	return r.reconcileAgentJob(ctx, cache)
+}
+
+func (r *AIMCacheReconciler) reconcileAgentDaemonSet(ctx context.Context, cache *aimv1alpha1.AIMCache) error {
+	ds := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      agentName(cache),
+			Namespace: cache.Namespace,
+		},
+	}
+
+	_, err := ctrl.CreateOrUpdate(ctx, r.Client, ds, func() error {
+		ds.Labels = agentLabels(cache)
+		ds.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(cache, aimv1alpha1.GroupVersion.WithKind("AIMCache")),
+		}
+		ds.Spec = appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: agentLabels(cache)},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: agentLabels(cache)},
+				Spec:       agentPodSpec(cache, corev1.RestartPolicyAlways),
+			},
+		}
+		return nil
+	})
+
+	return err
+}
+
+func (r *AIMCacheReconciler) cleanupAgentDaemonSet(ctx context.Context, cache *aimv1alpha1.AIMCache) error {
+	ds := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: agentName(cache), Namespace: cache.Namespace},
+	}
+	if err := r.Delete(ctx, ds); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// reconcileAgentJob dispatches a one-shot measurement Job for a
+// ReadWriteOnce cache, skipping re-creation while a report fresh enough to
+// still be useful already exists, or while a previous Job is still running.
+func (r *AIMCacheReconciler) reconcileAgentJob(ctx context.Context, cache *aimv1alpha1.AIMCache) error {
+	reports, err := r.listCacheReports(ctx, cache)
+	if err != nil {
+		return err
+	}
+	if report := freshestReport(reports); report != nil && report.Status.ObservedAt != nil &&
+		time.Since(report.Status.ObservedAt.Time) < agentJobRecheckInterval {
+		return nil
+	}
+
+	existing := &batchv1.Job{}
+	err = r.Get(ctx, types.NamespacedName{Name: agentName(cache), Namespace: cache.Namespace}, existing)
+	if err == nil {
+		if existing.Status.CompletionTime == nil && existing.Status.Failed == 0 {
+			// Still running with no failed attempt; let it finish.
+			return nil
+		}
+		// Finished, or has had a failed attempt (it may still be retrying):
+		// leave it for TTLSecondsAfterFinished to clean up, then the next
+		// reconcile will see it gone and create a fresh one.
+		return nil
+	}
+	if !errors.IsNotFound(err) {
+		return err
+	}
+
+	backoffLimit := int32(2)
+	ttl := int32(300)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      agentName(cache),
+			Namespace: cache.Namespace,
+			Labels:    agentLabels(cache),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cache, aimv1alpha1.GroupVersion.WithKind("AIMCache")),
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            &backoffLimit,
+			TTLSecondsAfterFinished: &ttl,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: agentLabels(cache)},
+				Spec:       agentPodSpec(cache, corev1.RestartPolicyOnFailure),
+			},
+		},
+	}
+
+	return r.Create(ctx, job)
+}
+
+func (r *AIMCacheReconciler) cleanupAgentJob(ctx context.Context, cache *aimv1alpha1.AIMCache) error {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: agentName(cache), Namespace: cache.Namespace},
+	}
+	background := metav1.DeletePropagationBackground
+	if err := r.Delete(ctx, job, &client.DeleteOptions{PropagationPolicy: &background}); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// listCacheReports returns every AIMCacheReport measuring cache.
+func (r *AIMCacheReconciler) listCacheReports(ctx context.Context, cache *aimv1alpha1.AIMCache) ([]aimv1alpha1.AIMCacheReport, error) {
+	var all aimv1alpha1.AIMCacheReportList
+	if err := r.List(ctx, &all, client.InNamespace(cache.Namespace)); err != nil {
+		return nil, err
+	}
+
+	reports := make([]aimv1alpha1.AIMCacheReport, 0, len(all.Items))
+	for _, report := range all.Items {
+		if report.Spec.CacheName == cache.Name {
+			reports = append(reports, report)
+		}
+	}
+	return reports, nil
+}
+
+// freshestReport picks the report AIMCacheReconciler should trust: the
+// current measurement leader if one has reported, otherwise whichever
+// report was observed most recently. Returns nil if no agent has reported yet.
+func freshestReport(reports []aimv1alpha1.AIMCacheReport) *aimv1alpha1.AIMCacheReport {
+	var best *aimv1alpha1.AIMCacheReport
+	for i := range reports {
+		report := &reports[i]
+		if report.Status.ObservedAt == nil {
+			continue
+		}
+		switch {
+		case best == nil:
+			best = report
+		case report.Status.Leader && !best.Status.Leader:
+			best = report
+		case report.Status.Leader == best.Status.Leader && report.Status.ObservedAt.After(best.Status.ObservedAt.Time):
+			best = report
+		}
+	}
+	return best
+}
+
+// modelUsage returns report's measurement for modelID, or nil if that model
+// hasn't been observed on disk (e.g. not downloaded yet).
+func modelUsage(report *aimv1alpha1.AIMCacheReport, modelID string) *aimv1alpha1.ModelUsageReport {
+	if report == nil {
+		return nil
+	}
+	for i := range report.Status.Models {
+		if report.Status.Models[i].ID == modelID {
+			return &report.Status.Models[i]
+		}
+	}
+	return nil
+}
+
 // updateStorageStatus updates the storage status information
 func (r *AIMCacheReconciler) updateStorageStatus(ctx context.Context, cache *aimv1alpha1.AIMCache) error {
+	if len(cache.Spec.Storage.Tiers) > 0 {
+		return r.updateTierStorageStatus(ctx, cache)
+	}
+
 	pvc := &corev1.PersistentVolumeClaim{}
 	err := r.Get(ctx, types.NamespacedName{
 		Name:      fmt.Sprintf("%s-storage", cache.Name),
@@ -197,128 +653,886 @@ func (r *AIMCacheReconciler) updateStorageStatus(ctx context.Context, cache *aim
 		return err
 	}
 
-	// Calculate storage usage
+	reports, err := r.listCacheReports(ctx, cache)
+	if err != nil {
+		return err
+	}
+	report := freshestReport(reports)
+
 	totalSize := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
-	usedSize := resource.MustParse("0") // This would be calculated from actual usage
+
+	var usedSize resource.Quantity
+	if report != nil && report.Status.TotalBytes != nil && report.Status.FreeBytes != nil {
+		usedSize = *resource.NewQuantity(*report.Status.TotalBytes-*report.Status.FreeBytes, resource.BinarySI)
+	} else {
+		// No agent has reported yet (just deployed, or a ReadWriteOnce
+		// volume isn't currently mounted anywhere): report zero used rather
+		// than blocking the rest of status on a measurement that doesn't exist yet.
+		usedSize = resource.MustParse("0")
+	}
 	availableSize := totalSize.DeepCopy()
 	availableSize.Sub(usedSize)
 
 	usagePercentage := float64(usedSize.Value()) / float64(totalSize.Value()) * 100
 
 	cache.Status.Storage = &aimv1alpha1.StorageStatus{
-		TotalSize:        totalSize.String(),
-		UsedSize:         usedSize.String(),
-		AvailableSize:    availableSize.String(),
-		UsagePercentage:  &usagePercentage,
-		PVCName:          pvc.Name,
-		PVCPhase:         string(pvc.Status.Phase),
+		TotalSize:       totalSize.String(),
+		UsedSize:        usedSize.String(),
+		AvailableSize:   availableSize.String(),
+		UsagePercentage: &usagePercentage,
+		PVCName:         pvc.Name,
+		PVCPhase:        string(pvc.Status.Phase),
 	}
 
 	return nil
 }
 
-// updateCachedModelsStatus updates the cached models status
+// updateTierStorageStatus is updateStorageStatus's per-tier counterpart:
+// each tier's used size is the sum of CachedModels currently assigned to it
+// (agent reports aren't tier-aware), and the cache-wide totals are the sum
+// across tiers.
+func (r *AIMCacheReconciler) updateTierStorageStatus(ctx context.Context, cache *aimv1alpha1.AIMCache) error {
+	usedByTier := make(map[string]int64, len(cache.Spec.Storage.Tiers))
+	for _, model := range cache.Status.CachedModels {
+		if model.Status != "cached" || model.Size == "" {
+			continue
+		}
+		size, err := resource.ParseQuantity(model.Size)
+		if err != nil {
+			continue
+		}
+		usedByTier[model.Tier] += size.Value()
+	}
+
+	tiers := make([]aimv1alpha1.StorageTierStatus, 0, len(cache.Spec.Storage.Tiers))
+	for _, tier := range cache.Spec.Storage.Tiers {
+		pvc := &corev1.PersistentVolumeClaim{}
+		err := r.Get(ctx, types.NamespacedName{Name: tierPVCName(cache, tier.Name), Namespace: cache.Namespace}, pvc)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		totalSize := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		usedSize := *resource.NewQuantity(usedByTier[tier.Name], resource.BinarySI)
+		aimCacheTierUsageBytes.WithLabelValues(cache.Namespace, cache.Name, tier.Name).Set(float64(usedSize.Value()))
+
+		tiers = append(tiers, aimv1alpha1.StorageTierStatus{
+			Name:      tier.Name,
+			TotalSize: totalSize.String(),
+			UsedSize:  usedSize.String(),
+			PVCName:   pvc.Name,
+			PVCPhase:  string(pvc.Status.Phase),
+		})
+	}
+
+	if len(tiers) == 0 {
+		cache.Status.Storage = nil
+		return nil
+	}
+
+	var totalCapacity, totalUsed resource.Quantity
+	for _, tier := range tiers {
+		capacity := resource.MustParse(tier.TotalSize)
+		used := resource.MustParse(tier.UsedSize)
+		totalCapacity.Add(capacity)
+		totalUsed.Add(used)
+	}
+
+	var usagePercentage float64
+	if totalCapacity.Value() > 0 {
+		usagePercentage = float64(totalUsed.Value()) / float64(totalCapacity.Value()) * 100
+	}
+	availableSize := totalCapacity.DeepCopy()
+	availableSize.Sub(totalUsed)
+
+	cache.Status.Storage = &aimv1alpha1.StorageStatus{
+		TotalSize:       totalCapacity.String(),
+		UsedSize:        totalUsed.String(),
+		AvailableSize:   availableSize.String(),
+		UsagePercentage: &usagePercentage,
+		Tiers:           tiers,
+	}
+
+	return nil
+}
+
+// cacheKey returns the assume-cache key for cache's own models.
+func cacheKey(cache *aimv1alpha1.AIMCache) string {
+	return fmt.Sprintf("%s/%s", cache.Namespace, cache.Name)
+}
+
+// syncAssumeCache feeds this reconcile's own (informer-cached) read of
+// cache.Status into r.models as the authoritative API-observed state. Every
+// Reconcile call is itself triggered by the manager's watch on AIMCache, so
+// doing this on every read has the same effect as a dedicated informer
+// event handler without needing one of our own: it clears any outstanding
+// Assume whose ResourceVersion this observation catches up to or passes.
+func (r *AIMCacheReconciler) syncAssumeCache(cache *aimv1alpha1.AIMCache) {
+	key := cacheKey(cache)
+	specByID := make(map[string]aimv1alpha1.ModelCacheSpec, len(cache.Spec.Models))
+	for _, model := range cache.Spec.Models {
+		specByID[model.ID] = model
+	}
+
+	for _, cachedModel := range cache.Status.CachedModels {
+		r.models.Update(assume.Model{
+			CacheKey:        key,
+			ModelID:         cachedModel.ID,
+			Priority:        specByID[cachedModel.ID].Priority,
+			StorageClass:    cache.Spec.Storage.StorageClass,
+			Status:          cachedModel,
+			ResourceVersion: cache.ResourceVersion,
+		})
+	}
+}
+
+// updateCachedModelsStatus updates the cached models status from the
+// freshest aim-cache-agent report, falling back to whatever was already
+// known (or a pending reservation) for models no agent has measured yet.
 func (r *AIMCacheReconciler) updateCachedModelsStatus(ctx context.Context, cache *aimv1alpha1.AIMCache) error {
-	// This would typically query the actual cache storage to determine what models are cached
-	// For now, we'll simulate this based on the configured models
+	r.syncAssumeCache(cache)
+	key := cacheKey(cache)
+
+	reports, err := r.listCacheReports(ctx, cache)
+	if err != nil {
+		return err
+	}
+	report := freshestReport(reports)
 
 	var cachedModels []aimv1alpha1.CachedModelStatus
 	totalModels := int32(0)
 	activeModels := int32(0)
 
 	for _, model := range cache.Spec.Models {
-		// Simulate cached model status
-		cachedModel := aimv1alpha1.CachedModelStatus{
-			ID:        model.ID,
-			Size:      "10Gi", // This would be calculated from actual storage
-			CachedAt:  &metav1.Time{Time: time.Now().Add(-time.Hour * 24)}, // Simulated
-			Status:    "cached",
-			AccessCount: func() *int64 { v := int64(0); return &v }(), // This would be tracked from actual usage
+		prior, hadPrior := r.models.Get(key, model.ID)
+		usage := modelUsage(report, model.ID)
+
+		var cachedModel aimv1alpha1.CachedModelStatus
+		switch {
+		case usage != nil:
+			cachedModel = aimv1alpha1.CachedModelStatus{
+				ID:           model.ID,
+				Size:         resource.NewQuantity(usage.SizeBytes, resource.BinarySI).String(),
+				LastAccessed: usage.LastAccessTime,
+				AccessCount:  usage.AccessCount,
+				Status:       "cached",
+			}
+			if hadPrior && prior.Status.Status == "cached" && prior.Status.CachedAt != nil {
+				// A model's first-cached timestamp shouldn't move just
+				// because a later report superseded the one before it.
+				cachedModel.CachedAt = prior.Status.CachedAt
+			} else {
+				now := metav1.Now()
+				cachedModel.CachedAt = &now
+			}
+		case hadPrior:
+			// No agent has measured this model in the freshest report (e.g.
+			// a ReadWriteOnce cache's Job hasn't run again yet); reuse the
+			// last known or assumed value instead of losing it.
+			cachedModel = prior.Status
+		default:
+			// Nothing known about this model yet: reserve a pending entry
+			// so a concurrent reconcile referencing this cache sees the
+			// same thing rather than each fabricating its own guess.
+			cachedModel = aimv1alpha1.CachedModelStatus{ID: model.ID, Status: "pending"}
 		}
 
-		// Simulate last accessed time
-		lastAccessed := metav1.Time{Time: time.Now().Add(-time.Hour * 2)}
-		cachedModel.LastAccessed = &lastAccessed
+		r.models.Assume(assume.Model{
+			CacheKey:        key,
+			ModelID:         model.ID,
+			Priority:        model.Priority,
+			StorageClass:    cache.Spec.Storage.StorageClass,
+			Status:          cachedModel,
+			ResourceVersion: cache.ResourceVersion,
+		})
 
 		cachedModels = append(cachedModels, cachedModel)
 		totalModels++
 
-		// Consider model active if accessed in last 24 hours
-		if time.Since(lastAccessed.Time) < time.Hour*24 {
+		if cachedModel.LastAccessed != nil && time.Since(cachedModel.LastAccessed.Time) < time.Hour*24 {
 			activeModels++
 		}
 	}
 
 	cache.Status.CachedModels = cachedModels
-	cache.Status.Usage = &aimv1alpha1.CacheUsageStatus{
-		TotalModels:  &totalModels,
-		ActiveModels: &activeModels,
-		LastCleanup:  &metav1.Time{Time: time.Now().Add(-time.Hour * 6)}, // Simulated
-		NextCleanup:  &metav1.Time{Time: time.Now().Add(time.Hour * 18)}, // Simulated
+
+	usage := &aimv1alpha1.CacheUsageStatus{TotalModels: &totalModels, ActiveModels: &activeModels}
+	if cache.Status.Usage != nil {
+		usage.LastCleanup = cache.Status.Usage.LastCleanup
+		usage.NextCleanup = cache.Status.Usage.NextCleanup
+		usage.LastMigration = cache.Status.Usage.LastMigration
 	}
+	cache.Status.Usage = usage
 
 	return nil
 }
 
+// preloadJobLabels are applied to every model-puller Job and its pod
+// template, distinct from agentLabels so countRunningPreloadJobs doesn't also
+// count the measurement agent's own Job.
+func preloadJobLabels(cache *aimv1alpha1.AIMCache) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":      "aim-model-puller",
+		"app.kubernetes.io/instance":  cache.Name,
+		"app.kubernetes.io/component": "preload",
+	}
+}
+
+// preloadJobName derives a DNS-1123-safe Job name from modelID, which may
+// contain characters (e.g. "/" in a HuggingFace repo ID) a Kubernetes name can't.
+func preloadJobName(cache *aimv1alpha1.AIMCache, modelID string) string {
+	sum := sha256.Sum256([]byte(modelID))
+	return fmt.Sprintf("%s-preload-%s", cache.Name, hex.EncodeToString(sum[:])[:12])
+}
+
+// preloadPodSpec is the Pod template for a model-puller Job: the cache PVC
+// mounted read-write (the puller needs to write into it), running
+// r.pullerImage() rather than the measurement agent's image.
+func preloadPodSpec(cache *aimv1alpha1.AIMCache, image string, model aimv1alpha1.ModelCacheSpec) corev1.PodSpec {
+	spec := agentPodSpec(cache, corev1.RestartPolicyOnFailure)
+	spec.Containers[0].Name = "puller"
+	spec.Containers[0].Image = image
+	for i := range spec.Containers[0].VolumeMounts {
+		spec.Containers[0].VolumeMounts[i].ReadOnly = false
+	}
+	spec.Containers[0].Env = append(spec.Containers[0].Env,
+		corev1.EnvVar{Name: "AIM_PRELOAD_MODEL_ID", Value: model.ID},
+		corev1.EnvVar{Name: "AIM_PRELOAD_CHECKSUM", Value: model.Checksum},
+	)
+	return spec
+}
+
+// countRunningPreloadJobs counts cache's preload Jobs that haven't finished
+// (successfully or not) yet, so reconcilePreload can respect maxConcurrentPreloads.
+func (r *AIMCacheReconciler) countRunningPreloadJobs(ctx context.Context, cache *aimv1alpha1.AIMCache) (int, error) {
+	var jobs batchv1.JobList
+	if err := r.List(ctx, &jobs, client.InNamespace(cache.Namespace), client.MatchingLabels(preloadJobLabels(cache))); err != nil {
+		return 0, err
+	}
+
+	running := 0
+	for _, job := range jobs.Items {
+		if job.Status.CompletionTime == nil && !(job.Status.Failed > 0 && job.Status.Active == 0) {
+			running++
+		}
+	}
+	return running, nil
+}
+
+// createPreloadJob dispatches a one-shot Job that downloads model into the
+// cache PVC. Retries on transient failure are handled by the Job's own
+// BackoffLimit; once that's exhausted the Job goes Failed and
+// syncPreloadStatus marks the model "failed" until TTLSecondsAfterFinished
+// cleans it up and reconcilePreload tries again from scratch.
+func (r *AIMCacheReconciler) createPreloadJob(ctx context.Context, cache *aimv1alpha1.AIMCache, model aimv1alpha1.ModelCacheSpec, jobName string) error {
+	backoffLimit := int32(3)
+	ttl := int32(3600)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName,
+			Namespace: cache.Namespace,
+			Labels:    preloadJobLabels(cache),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cache, aimv1alpha1.GroupVersion.WithKind("AIMCache")),
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            &backoffLimit,
+			TTLSecondsAfterFinished: &ttl,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: preloadJobLabels(cache)},
+				Spec:       preloadPodSpec(cache, r.pullerImage(), model),
+			},
+		},
+	}
+
+	return r.Create(ctx, job)
+}
+
+// syncPreloadStatus advances status to match job's lifecycle: cached once the
+// Job completes, failed once it exhausts its retries, otherwise downloading
+// with whatever progress the puller has patched onto preloadProgressAnnotation.
+func syncPreloadStatus(status *aimv1alpha1.CachedModelStatus, job *batchv1.Job) {
+	if status == nil {
+		return
+	}
+
+	switch {
+	case job.Status.CompletionTime != nil:
+		progress := 100.0
+		status.Status = "cached"
+		status.DownloadProgress = &progress
+		if status.CachedAt == nil {
+			now := metav1.Now()
+			status.CachedAt = &now
+		}
+	case job.Status.Failed > 0 && job.Status.Active == 0:
+		status.Status = "failed"
+	default:
+		status.Status = "downloading"
+		if raw, ok := job.Annotations[preloadProgressAnnotation]; ok {
+			if progress, err := strconv.ParseFloat(raw, 64); err == nil {
+				status.DownloadProgress = &progress
+			}
+		}
+	}
+}
+
+// reconcilePreload dispatches a preload Job per Preload=true model not yet
+// cached, bounded by maxConcurrentPreloads running at once; models beyond
+// that budget wait for a slot to free up on a later reconcile.
+func (r *AIMCacheReconciler) reconcilePreload(ctx context.Context, cache *aimv1alpha1.AIMCache) error {
+	statusByID := make(map[string]*aimv1alpha1.CachedModelStatus, len(cache.Status.CachedModels))
+	for i := range cache.Status.CachedModels {
+		statusByID[cache.Status.CachedModels[i].ID] = &cache.Status.CachedModels[i]
+	}
+
+	running, err := r.countRunningPreloadJobs(ctx, cache)
+	if err != nil {
+		return err
+	}
+	budget := r.maxConcurrentPreloads() - running
+
+	key := cacheKey(cache)
+	for _, model := range cache.Spec.Models {
+		if model.Preload == nil || !*model.Preload {
+			continue
+		}
+		status := statusByID[model.ID]
+		if status != nil && status.Status == "cached" {
+			continue
+		}
+
+		jobName := preloadJobName(cache, model.ID)
+		job := &batchv1.Job{}
+		err := r.Get(ctx, types.NamespacedName{Name: jobName, Namespace: cache.Namespace}, job)
+		switch {
+		case err == nil:
+			syncPreloadStatus(status, job)
+		case errors.IsNotFound(err):
+			if status != nil && status.Status == "failed" {
+				// The failed Job has already been cleaned up by its TTL;
+				// back off one reconcile before dispatching a fresh attempt.
+				status.Status = "pending"
+				continue
+			}
+			if budget <= 0 {
+				continue
+			}
+			if err := r.createPreloadJob(ctx, cache, model, jobName); err != nil {
+				return err
+			}
+			if status != nil {
+				status.Status = "downloading"
+			}
+			budget--
+		default:
+			return err
+		}
+
+		if status != nil {
+			r.models.Assume(assume.Model{
+				CacheKey:        key,
+				ModelID:         model.ID,
+				Priority:        model.Priority,
+				StorageClass:    cache.Spec.Storage.StorageClass,
+				Status:          *status,
+				ResourceVersion: cache.ResourceVersion,
+			})
+		}
+	}
+
+	return nil
+}
+
+// desiredTier picks the hottest tier (tiers ordered hot to cold) whose
+// AccessThreshold accessCount still satisfies, falling back to the coldest
+// tier so every model always has somewhere to live. Returns "" if no tiers
+// are configured.
+func desiredTier(tiers []aimv1alpha1.StorageTierSpec, accessCount int64) string {
+	if len(tiers) == 0 {
+		return ""
+	}
+	for _, tier := range tiers {
+		if tier.AccessThreshold == nil || accessCount >= *tier.AccessThreshold {
+			return tier.Name
+		}
+	}
+	return tiers[len(tiers)-1].Name
+}
+
+// tierMigrationJobName is the shared, single migration Job for cache; a new
+// batch of moves reuses the same name once the previous batch has finished.
+func tierMigrationJobName(cache *aimv1alpha1.AIMCache) string {
+	return fmt.Sprintf("%s-tier-migration", cache.Name)
+}
+
+// migrationPlanEnv encodes a batch of tier moves as "id=fromTier:toTier"
+// pairs, matching the comma-joined env var convention reconcileEvictionJob
+// already uses rather than introducing a structured payload.
+func migrationPlanEnv(moves map[string][2]string) string {
+	ids := make([]string, 0, len(moves))
+	for id := range moves {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		move := moves[id]
+		parts = append(parts, fmt.Sprintf("%s=%s:%s", id, move[0], move[1]))
+	}
+	return strings.Join(parts, ",")
+}
+
+// reconcileTierMigrationJob launches a one-shot Job, every tier's PVC
+// mounted read-write, that moves each model in moves from its current tier
+// directory to its new one. It reuses the aim-cache-agent image's "migrate"
+// mode, the same way reconcileEvictionJob reuses its "evict" mode.
+// reconcileTierMigrationJob returns whether it actually dispatched a Job for
+// moves: callers must not treat a move as having happened (updating
+// CachedModelStatus.Tier, incrementing aimCacheMigrationsTotal) unless this
+// is true, since a previous batch still running is a legitimate no-op.
+func (r *AIMCacheReconciler) reconcileTierMigrationJob(ctx context.Context, cache *aimv1alpha1.AIMCache, moves map[string][2]string) (bool, error) {
+	name := tierMigrationJobName(cache)
+
+	existing := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: cache.Namespace}, existing)
+	if err == nil {
+		if existing.Status.CompletionTime == nil {
+			// A previous migration batch is still running; fold into it next time.
+			return false, nil
+		}
+		background := metav1.DeletePropagationBackground
+		if err := r.Delete(ctx, existing, &client.DeleteOptions{PropagationPolicy: &background}); err != nil && !errors.IsNotFound(err) {
+			return false, err
+		}
+	} else if !errors.IsNotFound(err) {
+		return false, err
+	}
+
+	podSpec := agentPodSpec(cache, corev1.RestartPolicyOnFailure)
+	podSpec.Containers[0].Args = []string{"migrate"}
+	podSpec.Containers[0].Env = append(podSpec.Containers[0].Env, corev1.EnvVar{
+		Name: "AIM_CACHE_TIER_MIGRATIONS", Value: migrationPlanEnv(moves),
+	})
+	for i := range podSpec.Containers[0].VolumeMounts {
+		podSpec.Containers[0].VolumeMounts[i].ReadOnly = false
+	}
+
+	backoffLimit := int32(2)
+	ttl := int32(300)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: cache.Namespace,
+			Labels:    agentLabels(cache),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cache, aimv1alpha1.GroupVersion.WithKind("AIMCache")),
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            &backoffLimit,
+			TTLSecondsAfterFinished: &ttl,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: agentLabels(cache)},
+				Spec:       podSpec,
+			},
+		},
+	}
+
+	if err := r.Create(ctx, job); err != nil {
+		return false, err
+	}
+
+	for _, move := range moves {
+		aimCacheMigrationsTotal.WithLabelValues(cache.Namespace, cache.Name, move[0], move[1]).Inc()
+	}
+	return true, nil
+}
+
+// reconcileTierMigration runs at most once per tierMigrationInterval: for
+// every cached model it computes the tier its current AccessCount belongs
+// on and, if that differs from CachedModelStatus.Tier, batches it into a
+// single migration Job. The Tier field is updated optimistically, the same
+// way runCleanup's eviction path mutates CachedModels ahead of the Job
+// actually finishing.
+func (r *AIMCacheReconciler) reconcileTierMigration(ctx context.Context, cache *aimv1alpha1.AIMCache) error {
+	if len(cache.Spec.Storage.Tiers) == 0 {
+		return nil
+	}
+
+	now := metav1.Now()
+	if cache.Status.Usage != nil && cache.Status.Usage.LastMigration != nil &&
+		time.Since(cache.Status.Usage.LastMigration.Time) < tierMigrationInterval {
+		return nil
+	}
+
+	moves := map[string][2]string{}
+	for i := range cache.Status.CachedModels {
+		model := &cache.Status.CachedModels[i]
+		if model.Status != "cached" {
+			continue
+		}
+
+		var accessCount int64
+		if model.AccessCount != nil {
+			accessCount = *model.AccessCount
+		}
+
+		target := desiredTier(cache.Spec.Storage.Tiers, accessCount)
+		if target == model.Tier {
+			continue
+		}
+
+		moves[model.ID] = [2]string{model.Tier, target}
+	}
+
+	if len(moves) == 0 {
+		return nil
+	}
+
+	logger := log.FromContext(ctx)
+	logger.Info("Migrating cached models between storage tiers", "moves", moves)
+	dispatched, err := r.reconcileTierMigrationJob(ctx, cache, moves)
+	if err != nil {
+		return fmt.Errorf("launching tier migration job: %w", err)
+	}
+	if !dispatched {
+		// A previous migration batch is still running; leave Tier/LastMigration
+		// alone and try again next reconcile.
+		return nil
+	}
+
+	for i := range cache.Status.CachedModels {
+		model := &cache.Status.CachedModels[i]
+		if move, ok := moves[model.ID]; ok {
+			model.Tier = move[1]
+			model.LastMigration = &now
+		}
+	}
+
+	if cache.Status.Usage != nil {
+		cache.Status.Usage.LastMigration = &now
+	}
+	return nil
+}
+
 // runCleanup runs the cleanup process for the cache
 func (r *AIMCacheReconciler) runCleanup(ctx context.Context, cache *aimv1alpha1.AIMCache) error {
-	if cache.Spec.Cleanup == nil {
+	cleanup := cache.Spec.Cleanup
+	if cleanup == nil {
 		return nil
 	}
+	logger := log.FromContext(ctx)
+	now := metav1.Now()
+
+	if cleanup.Schedule != "" {
+		schedule, err := cron.ParseStandard(cleanup.Schedule)
+		if err != nil {
+			// The validating webhook rejects a malformed schedule at apply
+			// time, so this should only happen for objects that predate it.
+			return fmt.Errorf("parsing cleanup schedule %q: %w", cleanup.Schedule, err)
+		}
+		if cache.Status.Usage != nil && cache.Status.Usage.NextCleanup != nil &&
+			now.Time.Before(cache.Status.Usage.NextCleanup.Time) {
+			return nil
+		}
+		next := metav1.NewTime(schedule.Next(now.Time))
+		if cache.Status.Usage != nil {
+			cache.Status.Usage.NextCleanup = &next
+		}
+	}
 
-	// Check if cleanup is scheduled
-	if cache.Spec.Cleanup.Schedule != "" {
-		// This would use a cron parser to check if cleanup should run now
-		// For simplicity, we'll run cleanup every reconciliation if enabled
-		logger := log.FromContext(ctx)
-		logger.Info("Running scheduled cache cleanup")
+	candidates, err := evictionCandidates(cache)
+	if err != nil {
+		return fmt.Errorf("building eviction candidates: %w", err)
 	}
 
-	// Cleanup based on age
-	if cache.Spec.Cleanup.MaxAge != "" {
-		// Parse max age and remove old models
-		// This would remove models older than the specified age
+	toEvict := map[string]bool{}
+
+	if cleanup.MaxAge != "" {
+		maxAge, err := time.ParseDuration(cleanup.MaxAge)
+		if err != nil {
+			return fmt.Errorf("parsing cleanup maxAge %q: %w", cleanup.MaxAge, err)
+		}
+		for _, id := range evict.OlderThan(candidates, now.Time.Add(-maxAge)) {
+			toEvict[id] = true
+		}
 	}
 
-	// Cleanup based on free space
-	if cache.Spec.Cleanup.MinFreeSpace != "" {
-		// Ensure minimum free space is maintained
-		// This would remove models until minimum free space is available
+	if cleanup.MinFreeSpace != "" && cache.Status.Storage != nil {
+		minFree, err := resource.ParseQuantity(cleanup.MinFreeSpace)
+		if err != nil {
+			return fmt.Errorf("parsing cleanup minFreeSpace %q: %w", cleanup.MinFreeSpace, err)
+		}
+		available, err := resource.ParseQuantity(cache.Status.Storage.AvailableSize)
+		if err != nil {
+			return fmt.Errorf("parsing available storage %q: %w", cache.Status.Storage.AvailableSize, err)
+		}
+
+		strategy := cleanup.Strategy
+		if strategy == "" {
+			strategy = evict.StrategyLRU
+		}
+		plan, err := evict.PlanMinFreeSpace(candidates, strategy, available.Value(), minFree.Value())
+		if err != nil {
+			return fmt.Errorf("planning eviction: %w", err)
+		}
+		for _, id := range plan {
+			toEvict[id] = true
+		}
+	}
+
+	if len(toEvict) > 0 {
+		ids := make([]string, 0, len(toEvict))
+		for id := range toEvict {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		// A model with a colder tier left to fall back to, or with no tier
+		// assigned while the cache has tiers, is demoted instead of deleted
+		// outright; only a model already on the coldest tier, or in a cache
+		// with no tiers, is actually evicted.
+		candidateByID := make(map[string]evict.Candidate, len(candidates))
+		for _, candidate := range candidates {
+			candidateByID[candidate.ID] = candidate
+		}
+		tiers := tierNames(cache.Spec.Storage.Tiers)
+
+		var toDelete []string
+		demotions := map[string][2]string{}
+		for _, id := range ids {
+			if colder, ok := evict.ColderTier(tiers, candidateByID[id].Tier); ok {
+				demotions[id] = [2]string{candidateByID[id].Tier, colder}
+				continue
+			}
+			toDelete = append(toDelete, id)
+		}
+
+		if len(demotions) > 0 {
+			logger.Info("Demoting cached models to a colder tier instead of evicting", "models", demotions)
+			dispatched, err := r.reconcileTierMigrationJob(ctx, cache, demotions)
+			if err != nil {
+				return fmt.Errorf("launching tier demotion job: %w", err)
+			}
+			if dispatched {
+				for i := range cache.Status.CachedModels {
+					if move, ok := demotions[cache.Status.CachedModels[i].ID]; ok {
+						cache.Status.CachedModels[i].Tier = move[1]
+						cache.Status.CachedModels[i].LastMigration = &now
+					}
+				}
+			}
+		}
+
+		if len(toDelete) > 0 {
+			logger.Info("Evicting cached models", "models", toDelete)
+			if err := r.reconcileEvictionJob(ctx, cache, toDelete); err != nil {
+				return fmt.Errorf("launching eviction job: %w", err)
+			}
+
+			key := cacheKey(cache)
+			for _, id := range toDelete {
+				r.models.Restore(key, id)
+			}
+			removeEvictedModels(cache, toDelete)
+		}
 	}
 
-	// Update cleanup timestamps
-	now := metav1.Now()
 	if cache.Status.Usage != nil {
 		cache.Status.Usage.LastCleanup = &now
-		// Calculate next cleanup time based on schedule
-		nextCleanup := metav1.Time{Time: now.Time.Add(time.Hour * 24)}
-		cache.Status.Usage.NextCleanup = &nextCleanup
 	}
 
 	return nil
 }
 
+// evictionCandidates builds the evict.Candidate view of every model this
+// cache currently reports as cached; "pending" models aren't eviction
+// candidates, since there's nothing on disk to remove yet.
+func evictionCandidates(cache *aimv1alpha1.AIMCache) ([]evict.Candidate, error) {
+	specByID := make(map[string]aimv1alpha1.ModelCacheSpec, len(cache.Spec.Models))
+	for _, model := range cache.Spec.Models {
+		specByID[model.ID] = model
+	}
+
+	candidates := make([]evict.Candidate, 0, len(cache.Status.CachedModels))
+	for _, cachedModel := range cache.Status.CachedModels {
+		if cachedModel.Status != "cached" {
+			continue
+		}
+
+		var sizeBytes int64
+		if cachedModel.Size != "" {
+			size, err := resource.ParseQuantity(cachedModel.Size)
+			if err != nil {
+				return nil, fmt.Errorf("model %s: parsing size %q: %w", cachedModel.ID, cachedModel.Size, err)
+			}
+			sizeBytes = size.Value()
+		}
+
+		var accessCount int64
+		if cachedModel.AccessCount != nil {
+			accessCount = *cachedModel.AccessCount
+		}
+
+		candidate := evict.Candidate{
+			ID:          cachedModel.ID,
+			Priority:    specByID[cachedModel.ID].Priority,
+			SizeBytes:   sizeBytes,
+			AccessCount: accessCount,
+			Tier:        cachedModel.Tier,
+		}
+		if cachedModel.LastAccessed != nil {
+			candidate.LastAccessed = cachedModel.LastAccessed.Time
+		}
+		if cachedModel.CachedAt != nil {
+			candidate.CachedAt = cachedModel.CachedAt.Time
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, nil
+}
+
+// removeEvictedModels drops evicted model IDs from cache.Status.CachedModels
+// and recomputes Usage.TotalModels/ActiveModels to match.
+func removeEvictedModels(cache *aimv1alpha1.AIMCache, evicted []string) {
+	evictedSet := make(map[string]bool, len(evicted))
+	for _, id := range evicted {
+		evictedSet[id] = true
+	}
+
+	kept := cache.Status.CachedModels[:0]
+	activeModels := int32(0)
+	for _, cachedModel := range cache.Status.CachedModels {
+		if evictedSet[cachedModel.ID] {
+			continue
+		}
+		kept = append(kept, cachedModel)
+		if cachedModel.LastAccessed != nil && time.Since(cachedModel.LastAccessed.Time) < time.Hour*24 {
+			activeModels++
+		}
+	}
+	cache.Status.CachedModels = kept
+
+	if cache.Status.Usage != nil {
+		total := int32(len(kept))
+		cache.Status.Usage.TotalModels = &total
+		cache.Status.Usage.ActiveModels = &activeModels
+	}
+}
+
+// reconcileEvictionJob launches a one-shot Job, the cache PVC mounted
+// read-write, that deletes modelIDs' directories. It reuses the
+// aim-cache-agent image's "evict" mode rather than a separate image, since
+// the agent already knows the on-disk layout from measuring it.
+func (r *AIMCacheReconciler) reconcileEvictionJob(ctx context.Context, cache *aimv1alpha1.AIMCache, modelIDs []string) error {
+	name := fmt.Sprintf("%s-evict", cache.Name)
+
+	existing := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: cache.Namespace}, existing)
+	if err == nil {
+		if existing.Status.CompletionTime == nil {
+			// A previous eviction is still running; fold into it next time.
+			return nil
+		}
+		background := metav1.DeletePropagationBackground
+		if err := r.Delete(ctx, existing, &client.DeleteOptions{PropagationPolicy: &background}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	} else if !errors.IsNotFound(err) {
+		return err
+	}
+
+	podSpec := agentPodSpec(cache, corev1.RestartPolicyOnFailure)
+	podSpec.Containers[0].Args = []string{"evict"}
+	podSpec.Containers[0].Env = append(podSpec.Containers[0].Env, corev1.EnvVar{
+		Name: "AIM_CACHE_EVICT_MODEL_IDS", Value: strings.Join(modelIDs, ","),
+	})
+	for i := range podSpec.Containers[0].VolumeMounts {
+		podSpec.Containers[0].VolumeMounts[i].ReadOnly = false
+	}
+
+	backoffLimit := int32(2)
+	ttl := int32(300)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: cache.Namespace,
+			Labels:    agentLabels(cache),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cache, aimv1alpha1.GroupVersion.WithKind("AIMCache")),
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            &backoffLimit,
+			TTLSecondsAfterFinished: &ttl,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: agentLabels(cache)},
+				Spec:       podSpec,
+			},
+		},
+	}
+
+	return r.Create(ctx, job)
+}
+
+// listCacheBindings returns every AIMCacheBinding pointing at cache.
+func (r *AIMCacheReconciler) listCacheBindings(ctx context.Context, cache *aimv1alpha1.AIMCache) ([]aimv1alpha1.AIMCacheBinding, error) {
+	var all aimv1alpha1.AIMCacheBindingList
+	if err := r.List(ctx, &all, client.InNamespace(cache.Namespace)); err != nil {
+		return nil, err
+	}
+
+	bindings := make([]aimv1alpha1.AIMCacheBinding, 0, len(all.Items))
+	for _, binding := range all.Items {
+		if binding.Spec.CacheName == cache.Name {
+			bindings = append(bindings, binding)
+		}
+	}
+	return bindings, nil
+}
+
+// updateReferencedBy populates cache.Status.ReferencedBy from real
+// AIMCacheBindings, replacing the prior approximation of treating every
+// endpoint with caching enabled as a dependent.
+func (r *AIMCacheReconciler) updateReferencedBy(ctx context.Context, cache *aimv1alpha1.AIMCache) error {
+	bindings, err := r.listCacheBindings(ctx, cache)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(bindings))
+	for _, binding := range bindings {
+		names = append(names, binding.Spec.EndpointName)
+	}
+	sort.Strings(names)
+	cache.Status.ReferencedBy = names
+	return nil
+}
+
 // handleDeletion handles the deletion of the cache
 func (r *AIMCacheReconciler) handleDeletion(ctx context.Context, cache *aimv1alpha1.AIMCache) (ctrl.Result, error) {
-	// Check if any endpoints are using this cache
-	endpoints := &aimv1alpha1.AIMEndpointList{}
-	err := r.List(ctx, endpoints)
+	// Check if any endpoints are bound to this cache via an AIMCacheBinding
+	bindings, err := r.listCacheBindings(ctx, cache)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	usingEndpoints := []string{}
-	for _, endpoint := range endpoints.Items {
-		if endpoint.Spec.Cache.Enabled != nil && *endpoint.Spec.Cache.Enabled {
-			// Check if this endpoint is using the cache
-			// This would require more sophisticated logic to determine cache usage
-			usingEndpoints = append(usingEndpoints, fmt.Sprintf("%s/%s", endpoint.Namespace, endpoint.Name))
-		}
+	usingEndpoints := make([]string, 0, len(bindings))
+	for _, binding := range bindings {
+		usingEndpoints = append(usingEndpoints, binding.Spec.EndpointName)
 	}
+	sort.Strings(usingEndpoints)
 
 	if len(usingEndpoints) > 0 {
 		// Cache is still in use, prevent deletion
 		cache.Status.Phase = "DeletionBlocked"
+		cache.Status.ReferencedBy = usingEndpoints
 		cache.Status.Conditions = []metav1.Condition{
 			{
 				Type:               "DeletionBlocked",
@@ -339,4 +1553,4 @@ func (r *AIMCacheReconciler) handleDeletion(ctx context.Context, cache *aimv1alp
 	}
 
 	return ctrl.Result{}, nil
-} 
\ No newline at end of file
+}
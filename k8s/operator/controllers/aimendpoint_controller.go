@@ -20,9 +20,11 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -31,17 +33,24 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	aimv1alpha1 "github.com/aim-engine/operator/api/v1alpha1"
+	"github.com/aim-engine/operator/pkg/recipeselect"
+	"github.com/aim-engine/operator/pkg/statuscheck"
 	appsv1 "k8s.io/api/apps/v1"
-	corev1 "k8s.io/api/core/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	vpav1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/client-go/tools/record"
 )
 
 // AIMEndpointReconciler reconciles a AIMEndpoint object
 type AIMEndpointReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
 }
 
 //+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimendpoints,verbs=get;list;watch;create;update;patch;delete
@@ -49,12 +58,18 @@ type AIMEndpointReconciler struct {
 //+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimendpoints/finalizers,verbs=update
 //+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimrecipes,verbs=get;list;watch
 //+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimcaches,verbs=get;list;watch
+//+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimcachebindings,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=leaderworkerset.x-k8s.io,resources=leaderworkersets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=autoscaling.k8s.io,resources=verticalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=keda.sh,resources=scaledobjects,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=monitoring.coreos.com,resources=prometheusrules,verbs=get;list;watch;create;update;patch;delete
 
@@ -125,36 +140,87 @@ func (r *AIMEndpointReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{RequeueAfter: time.Minute}, err
 	}
 
+	// AnnotationDryRunRecipeSelection previews which recipe would be chosen
+	// without provisioning anything, so a user can check a spec change's
+	// effect on selection before committing to it.
+	if aimEndpoint.Annotations[aimv1alpha1.AnnotationDryRunRecipeSelection] == "true" {
+		aimEndpoint.Status.Phase = "DryRun"
+		if err := r.Status().Update(ctx, aimEndpoint); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
 	// Create or update ConfigMap
 	if err := r.reconcileConfigMap(ctx, aimEndpoint); err != nil {
 		logger.Error(err, "Failed to reconcile ConfigMap")
 		return ctrl.Result{}, err
 	}
 
-	// Create or update PVC if caching is enabled
-	if aimEndpoint.Spec.Cache.Enabled != nil && *aimEndpoint.Spec.Cache.Enabled {
+	// Create or update PVC if caching is enabled and no shared cache is referenced
+	if aimEndpoint.Spec.Cache.Enabled != nil && *aimEndpoint.Spec.Cache.Enabled && aimEndpoint.Spec.Cache.CacheRef == nil {
 		if err := r.reconcilePVC(ctx, aimEndpoint); err != nil {
 			logger.Error(err, "Failed to reconcile PVC")
 			return ctrl.Result{}, err
 		}
 	}
 
-	// Create or update Deployment
-	if err := r.reconcileDeployment(ctx, aimEndpoint); err != nil {
-		logger.Error(err, "Failed to reconcile Deployment")
+	// Keep this endpoint's AIMCacheBinding set in sync with which AIMCaches
+	// currently serve its model, so AIMCacheStatus.ReferencedBy reflects real
+	// dependents instead of every endpoint that merely has caching enabled.
+	if err := r.reconcileCacheBindings(ctx, aimEndpoint); err != nil {
+		logger.Error(err, "Failed to reconcile cache bindings")
 		return ctrl.Result{}, err
 	}
 
+	// Create or update the workload: a single apps/v1 Deployment in
+	// singleNode mode (the default), or a LeaderWorkerSet/StatefulSet pair
+	// spanning Topology.WorkerReplicas nodes in multiNode mode.
+	// reconcileMultiNode also cleans up whichever multi-node backend isn't
+	// currently selected, or every one of them in singleNode mode.
+	if aimEndpoint.Spec.Topology.IsMultiNode() {
+		if err := r.reconcileMultiNode(ctx, aimEndpoint); err != nil {
+			logger.Error(err, "Failed to reconcile multi-node workload")
+			return ctrl.Result{}, err
+		}
+		if err := r.cleanupDeployment(ctx, aimEndpoint); err != nil {
+			logger.Error(err, "Failed to clean up single-node Deployment")
+			return ctrl.Result{}, err
+		}
+	} else {
+		if err := r.reconcileDeployment(ctx, aimEndpoint); err != nil {
+			logger.Error(err, "Failed to reconcile Deployment")
+			return ctrl.Result{}, err
+		}
+		if err := r.reconcileMultiNode(ctx, aimEndpoint); err != nil {
+			logger.Error(err, "Failed to clean up multi-node workload")
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Create or update Service
 	if err := r.reconcileService(ctx, aimEndpoint); err != nil {
 		logger.Error(err, "Failed to reconcile Service")
 		return ctrl.Result{}, err
 	}
 
-	// Create or update HPA if scaling is configured
-	if aimEndpoint.Spec.Scaling.MaxReplicas != nil && *aimEndpoint.Spec.Scaling.MaxReplicas > 1 {
-		if err := r.reconcileHPA(ctx, aimEndpoint); err != nil {
-			logger.Error(err, "Failed to reconcile HPA")
+	// Reconcile the autoscaler selected by Scaling.AutoscalerClass, garbage
+	// collecting any autoscaler left over from a previously selected class
+	if err := r.reconcileAutoscaler(ctx, aimEndpoint); err != nil {
+		logger.Error(err, "Failed to reconcile autoscaler")
+		return ctrl.Result{}, err
+	}
+
+	// Create or update a VerticalPodAutoscaler if enabled, otherwise clean up
+	// any VPA left over from before it was disabled.
+	if aimEndpoint.Spec.Scaling.VPA != nil && aimEndpoint.Spec.Scaling.VPA.Enabled != nil && *aimEndpoint.Spec.Scaling.VPA.Enabled {
+		if err := r.reconcileVPA(ctx, aimEndpoint); err != nil {
+			logger.Error(err, "Failed to reconcile VerticalPodAutoscaler")
+			return ctrl.Result{}, err
+		}
+	} else {
+		if err := r.cleanupVPA(ctx, aimEndpoint); err != nil {
+			logger.Error(err, "Failed to clean up VerticalPodAutoscaler")
 			return ctrl.Result{}, err
 		}
 	}
@@ -168,61 +234,93 @@ func (r *AIMEndpointReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	// Update status
-	if err := r.updateStatus(ctx, aimEndpoint); err != nil {
+	result, err := r.updateStatus(ctx, aimEndpoint)
+	if err != nil {
 		logger.Error(err, "Failed to update status")
 		return ctrl.Result{}, err
 	}
 
 	logger.Info("Successfully reconciled AIMEndpoint")
-	return ctrl.Result{RequeueAfter: time.Minute * 5}, nil
+	return result, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *AIMEndpointReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("aimendpoint-controller")
+	}
+
+	if err := vpav1.AddToScheme(mgr.GetScheme()); err != nil {
+		return err
+	}
+
+	// Backfill AIMCacheBindings for every AIMEndpoint that existed before
+	// this migration shipped, so AIMCacheStatus.ReferencedBy is accurate
+	// immediately instead of waiting for each endpoint's next spec change.
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		return r.backfillCacheBindings(ctx)
+	})); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&aimv1alpha1.AIMEndpoint{}).
 		Owns(&appsv1.Deployment{}).
+		Owns(&appsv1.StatefulSet{}).
 		Owns(&corev1.Service{}).
 		Owns(&corev1.ConfigMap{}).
 		Owns(&corev1.PersistentVolumeClaim{}).
 		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
+		Owns(&vpav1.VerticalPodAutoscaler{}).
+		Owns(&aimv1alpha1.AIMCacheBinding{}).
 		Complete(r)
 }
 
+// backfillCacheBindings scans every existing AIMEndpoint once at manager
+// startup and creates any AIMCacheBinding it's missing, migrating endpoints
+// that predate this reference-tracking mechanism without waiting for their
+// next spec-driven reconcile.
+func (r *AIMEndpointReconciler) backfillCacheBindings(ctx context.Context) error {
+	var endpoints aimv1alpha1.AIMEndpointList
+	if err := r.List(ctx, &endpoints); err != nil {
+		return err
+	}
+
+	for i := range endpoints.Items {
+		endpoint := &endpoints.Items[i]
+		if !endpoint.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := r.reconcileCacheBindings(ctx, endpoint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // selectRecipe selects the appropriate recipe for the endpoint
 func (r *AIMEndpointReconciler) selectRecipe(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
 	if endpoint.Spec.Recipe.AutoSelect {
 		// Auto-select recipe based on model and available hardware
-		recipe, err := r.findBestRecipe(ctx, endpoint.Spec.Model.ID)
+		recipe, bestConfig, explanation, err := r.findBestRecipe(ctx, endpoint)
+		endpoint.Status.RecipeSelection = recipeSelectionStatus(explanation)
 		if err != nil {
-			return err
-		}
-		
-		// Find the best configuration for the requested GPU count
-		var bestConfig *aimv1alpha1.GPUConfiguration
-		requestedGPUCount := int32(1)
-		if endpoint.Spec.Resources.GPUCount != nil {
-			requestedGPUCount = *endpoint.Spec.Resources.GPUCount
-		}
-		
-		for i := range recipe.Spec.Configurations {
-			config := &recipe.Spec.Configurations[i]
-			if config.Enabled && config.GPUCount == requestedGPUCount {
-				bestConfig = config
-				break
+			if r.Recorder != nil {
+				r.Recorder.Event(endpoint, corev1.EventTypeWarning, "RecipeSelectionFailed", err.Error())
 			}
+			return err
 		}
-		
-		if bestConfig == nil {
-			return fmt.Errorf("no suitable configuration found for %d GPUs", requestedGPUCount)
-		}
-		
+
 		endpoint.Status.SelectedRecipe = &aimv1alpha1.SelectedRecipeStatus{
 			Name:      recipe.Name,
 			GPUCount:  &bestConfig.GPUCount,
 			Precision: recipe.Spec.Precision,
 			Backend:   recipe.Spec.Backend,
 		}
+
+		if r.Recorder != nil {
+			r.Recorder.Event(endpoint, corev1.EventTypeNormal, "RecipeSelected", fmt.Sprintf("selected recipe %s (score %d): %s", explanation.Selected, explanation.Score, strings.Join(explanation.Reasons, "; ")))
+		}
 	} else if endpoint.Spec.Recipe.CustomRecipe != nil {
 		// Use custom recipe
 		recipe := &aimv1alpha1.AIMRecipe{}
@@ -231,45 +329,240 @@ func (r *AIMEndpointReconciler) selectRecipe(ctx context.Context, endpoint *aimv
 		if endpoint.Spec.Recipe.CustomRecipe.Namespace != "" {
 			recipeNamespace = endpoint.Spec.Recipe.CustomRecipe.Namespace
 		}
-		
+
 		err := r.Get(ctx, types.NamespacedName{Name: recipeName, Namespace: recipeNamespace}, recipe)
 		if err != nil {
 			return fmt.Errorf("failed to get custom recipe %s: %v", recipeName, err)
 		}
-		
+
 		endpoint.Status.SelectedRecipe = &aimv1alpha1.SelectedRecipeStatus{
 			Name:      recipe.Name,
 			Precision: recipe.Spec.Precision,
 			Backend:   recipe.Spec.Backend,
 		}
 	}
-	
+
 	return nil
 }
 
-// findBestRecipe finds the best recipe for a given model
-func (r *AIMEndpointReconciler) findBestRecipe(ctx context.Context, modelID string) (*aimv1alpha1.AIMRecipe, error) {
+// findBestRecipe lists the recipes available for the endpoint's model and
+// scores them against the endpoint's requested hardware, precision, backend,
+// and GPU count via pkg/recipeselect, returning the winner and an
+// Explanation describing the decision for the RecipeSelected event.
+func (r *AIMEndpointReconciler) findBestRecipe(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) (*aimv1alpha1.AIMRecipe, *aimv1alpha1.GPUConfiguration, recipeselect.Explanation, error) {
 	recipes := &aimv1alpha1.AIMRecipeList{}
-	err := r.List(ctx, recipes)
+	if err := r.List(ctx, recipes); err != nil {
+		return nil, nil, recipeselect.Explanation{}, err
+	}
+
+	var candidates []aimv1alpha1.AIMRecipe
+	for _, recipe := range recipes.Items {
+		if recipe.Spec.ModelID == endpoint.Spec.Model.ID {
+			candidates = append(candidates, recipe)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, nil, recipeselect.Explanation{}, fmt.Errorf("no recipe found for model %s", endpoint.Spec.Model.ID)
+	}
+
+	opts := recipeselect.Options{}
+	if inventory, err := r.nodeInventory(ctx); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list node inventory, scoring without it")
+	} else {
+		opts.Inventory = inventory
+	}
+	opts.CacheHit = func(modelID string) bool {
+		return r.modelCached(ctx, endpoint.Namespace, modelID)
+	}
+
+	recipe, config, explanation, err := recipeselect.Select(endpoint.Spec, candidates, opts)
 	if err != nil {
+		return nil, nil, explanation, fmt.Errorf("no recipe for model %s satisfies the endpoint's requirements: %v", endpoint.Spec.Model.ID, err)
+	}
+
+	return recipe, config, explanation, nil
+}
+
+// recipeSelectionStatus converts a recipeselect.Explanation into its wire
+// equivalent for AIMEndpointStatus.RecipeSelection.
+func recipeSelectionStatus(explanation recipeselect.Explanation) *aimv1alpha1.RecipeSelectionStatus {
+	status := &aimv1alpha1.RecipeSelectionStatus{
+		Selected: explanation.Selected,
+		Score:    explanation.Score,
+		Reasons:  explanation.Reasons,
+	}
+	for _, rejected := range explanation.Rejected {
+		status.Rejected = append(status.Rejected, aimv1alpha1.RejectedRecipeStatus{
+			Name:   rejected.Name,
+			Reason: rejected.Reason,
+		})
+	}
+	return status
+}
+
+// nodeInventory aggregates the cluster's real GPU capacity from
+// node.Status.Allocatable["amd.com/gpu"] and the amd.com/gpu.product label,
+// so recipe selection can reject configurations no node could ever schedule
+// instead of only checking a recipe's declared GPUCount against the request.
+func (r *AIMEndpointReconciler) nodeInventory(ctx context.Context) (*recipeselect.NodeInventory, error) {
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes); err != nil {
 		return nil, err
 	}
-	
-	var bestRecipe *aimv1alpha1.AIMRecipe
-	for i := range recipes.Items {
-		recipe := &recipes.Items[i]
-		if recipe.Spec.ModelID == modelID {
-			if bestRecipe == nil || recipe.CreationTimestamp.Before(&bestRecipe.CreationTimestamp) {
-				bestRecipe = recipe
+
+	inventory := &recipeselect.NodeInventory{ProductCounts: map[string]int32{}}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		allocatable, ok := node.Status.Allocatable["amd.com/gpu"]
+		if !ok {
+			continue
+		}
+		gpuCount := int32(allocatable.Value())
+		if gpuCount == 0 {
+			continue
+		}
+		if gpuCount > inventory.MaxSchedulableGPUCount {
+			inventory.MaxSchedulableGPUCount = gpuCount
+		}
+		if product := node.Labels["amd.com/gpu.product"]; product != "" {
+			inventory.ProductCounts[product]++
+		}
+	}
+
+	return inventory, nil
+}
+
+// modelCached reports whether modelID already appears as a cached model in
+// any AIMCache in namespace, used to prefer a recipe whose model is already
+// warm over an equally-good recipe that would require a cold download.
+func (r *AIMEndpointReconciler) modelCached(ctx context.Context, namespace, modelID string) bool {
+	return len(r.cachesServingModel(ctx, namespace, modelID)) > 0
+}
+
+// cachesServingModel returns the name of every AIMCache in namespace that
+// currently reports modelID as cached. Used by modelCached as an advisory
+// signal for recipe scoring only -- it intentionally considers every AIMCache
+// in the namespace, not just ones the endpoint depends on, since a wrong
+// guess here only costs a worse recipe score, never a deletion-blocking
+// dependency. reconcileCacheBindings must NOT use this directly; see
+// boundCaches for the binding-eligible subset.
+func (r *AIMEndpointReconciler) cachesServingModel(ctx context.Context, namespace, modelID string) []string {
+	caches := &aimv1alpha1.AIMCacheList{}
+	if err := r.List(ctx, caches, client.InNamespace(namespace)); err != nil {
+		return nil
+	}
+
+	var names []string
+	for _, cache := range caches.Items {
+		for _, cached := range cache.Status.CachedModels {
+			if cached.ID == modelID && cached.Status == "cached" {
+				names = append(names, cache.Name)
+				break
 			}
 		}
 	}
-	
-	if bestRecipe == nil {
-		return nil, fmt.Errorf("no recipe found for model %s", modelID)
+	return names
+}
+
+// boundCaches returns the subset of cachesServingModel's result that endpoint
+// actually depends on: caching must be enabled, and the cache must be one of
+// the names endpoint.Spec.Cache.AIMCacheNames explicitly lists. This is the
+// set reconcileCacheBindings creates AIMCacheBindings for -- unlike
+// cachesServingModel's broader, advisory-only result, a binding here blocks
+// the named AIMCache's deletion, so it must reflect a real, explicit
+// dependency rather than a coincidental shared model ID.
+func (r *AIMEndpointReconciler) boundCaches(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) []string {
+	if endpoint.Spec.Cache.Enabled == nil || !*endpoint.Spec.Cache.Enabled || len(endpoint.Spec.Cache.AIMCacheNames) == 0 {
+		return nil
+	}
+
+	named := make(map[string]bool, len(endpoint.Spec.Cache.AIMCacheNames))
+	for _, name := range endpoint.Spec.Cache.AIMCacheNames {
+		named[name] = true
+	}
+
+	var bound []string
+	for _, cacheName := range r.cachesServingModel(ctx, endpoint.Namespace, endpoint.Spec.Model.ID) {
+		if named[cacheName] {
+			bound = append(bound, cacheName)
+		}
+	}
+	return bound
+}
+
+// cacheBindingName derives the AIMCacheBinding object name for one
+// endpoint/cache pair.
+func cacheBindingName(endpoint *aimv1alpha1.AIMEndpoint, cacheName string) string {
+	return fmt.Sprintf("%s-%s-binding", endpoint.Name, cacheName)
+}
+
+// listCacheBindings returns every AIMCacheBinding endpoint currently holds.
+func (r *AIMEndpointReconciler) listCacheBindings(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) ([]aimv1alpha1.AIMCacheBinding, error) {
+	var all aimv1alpha1.AIMCacheBindingList
+	if err := r.List(ctx, &all, client.InNamespace(endpoint.Namespace)); err != nil {
+		return nil, err
+	}
+
+	mine := make([]aimv1alpha1.AIMCacheBinding, 0, len(all.Items))
+	for _, binding := range all.Items {
+		if binding.Spec.EndpointName == endpoint.Name {
+			mine = append(mine, binding)
+		}
+	}
+	return mine, nil
+}
+
+// reconcileCacheBinding creates or updates the AIMCacheBinding recording that
+// endpoint depends on cacheName.
+func (r *AIMEndpointReconciler) reconcileCacheBinding(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint, cacheName string) error {
+	binding := &aimv1alpha1.AIMCacheBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cacheBindingName(endpoint, cacheName),
+			Namespace: endpoint.Namespace,
+		},
+	}
+
+	_, err := ctrl.CreateOrUpdate(ctx, r.Client, binding, func() error {
+		binding.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(endpoint, aimv1alpha1.GroupVersion.WithKind("AIMEndpoint")),
+		}
+		binding.Spec = aimv1alpha1.AIMCacheBindingSpec{
+			EndpointName: endpoint.Name,
+			CacheName:    cacheName,
+		}
+		return nil
+	})
+	return err
+}
+
+// reconcileCacheBindings keeps endpoint's AIMCacheBinding set in sync with
+// boundCaches: a binding is created for every AIMCacheNames entry currently
+// serving its model, and any binding left over from a cache that's no longer
+// named, no longer serving the model, or from the endpoint having switched
+// models or disabled caching, is deleted.
+func (r *AIMEndpointReconciler) reconcileCacheBindings(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
+	wanted := map[string]bool{}
+	for _, cacheName := range r.boundCaches(ctx, endpoint) {
+		wanted[cacheName] = true
+		if err := r.reconcileCacheBinding(ctx, endpoint, cacheName); err != nil {
+			return err
+		}
+	}
+
+	existing, err := r.listCacheBindings(ctx, endpoint)
+	if err != nil {
+		return err
 	}
-	
-	return bestRecipe, nil
+	for i := range existing {
+		binding := &existing[i]
+		if !wanted[binding.Spec.CacheName] {
+			if err := r.Delete(ctx, binding); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // reconcileConfigMap creates or updates the ConfigMap for the endpoint
@@ -280,34 +573,34 @@ func (r *AIMEndpointReconciler) reconcileConfigMap(ctx context.Context, endpoint
 			Namespace: endpoint.Namespace,
 		},
 	}
-	
+
 	_, err := ctrl.CreateOrUpdate(ctx, r.Client, configMap, func() error {
 		configMap.Labels = map[string]string{
 			"app.kubernetes.io/name":      "aim-endpoint",
 			"app.kubernetes.io/instance":  endpoint.Name,
 			"app.kubernetes.io/component": "config",
 		}
-		
+
 		configMap.OwnerReferences = []metav1.OwnerReference{
 			*metav1.NewControllerRef(endpoint, aimv1alpha1.GroupVersion.WithKind("AIMEndpoint")),
 		}
-		
+
 		// Add configuration data
 		configMap.Data = map[string]string{
 			"model.id":       endpoint.Spec.Model.ID,
 			"model.version":  endpoint.Spec.Model.Version,
 			"model.revision": endpoint.Spec.Model.Revision,
 		}
-		
+
 		if endpoint.Status.SelectedRecipe != nil {
 			configMap.Data["recipe.name"] = endpoint.Status.SelectedRecipe.Name
 			configMap.Data["recipe.precision"] = endpoint.Status.SelectedRecipe.Precision
 			configMap.Data["recipe.backend"] = endpoint.Status.SelectedRecipe.Backend
 		}
-		
+
 		return nil
 	})
-	
+
 	return err
 }
 
@@ -319,38 +612,42 @@ func (r *AIMEndpointReconciler) reconcilePVC(ctx context.Context, endpoint *aimv
 			Namespace: endpoint.Namespace,
 		},
 	}
-	
+
 	_, err := ctrl.CreateOrUpdate(ctx, r.Client, pvc, func() error {
 		pvc.Labels = map[string]string{
 			"app.kubernetes.io/name":      "aim-endpoint",
 			"app.kubernetes.io/instance":  endpoint.Name,
 			"app.kubernetes.io/component": "cache",
 		}
-		
+
 		pvc.OwnerReferences = []metav1.OwnerReference{
 			*metav1.NewControllerRef(endpoint, aimv1alpha1.GroupVersion.WithKind("AIMEndpoint")),
 		}
-		
+
+		size := resource.Quantity{}
+		if endpoint.Spec.Cache.Size != nil {
+			size = *endpoint.Spec.Cache.Size
+		}
 		pvc.Spec = corev1.PersistentVolumeClaimSpec{
 			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
 			Resources: corev1.ResourceRequirements{
 				Requests: corev1.ResourceList{
-					corev1.ResourceStorage: resource.MustParse(endpoint.Spec.Cache.Size),
+					corev1.ResourceStorage: size,
 				},
 			},
 		}
-		
+
 		if endpoint.Spec.Cache.StorageClass != "" {
 			pvc.Spec.StorageClassName = &endpoint.Spec.Cache.StorageClass
 		}
-		
+
 		if endpoint.Spec.Cache.AccessMode != "" {
 			pvc.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.PersistentVolumeAccessMode(endpoint.Spec.Cache.AccessMode)}
 		}
-		
+
 		return nil
 	})
-	
+
 	return err
 }
 
@@ -362,25 +659,25 @@ func (r *AIMEndpointReconciler) reconcileDeployment(ctx context.Context, endpoin
 			Namespace: endpoint.Namespace,
 		},
 	}
-	
+
 	_, err := ctrl.CreateOrUpdate(ctx, r.Client, deployment, func() error {
 		deployment.Labels = map[string]string{
 			"app.kubernetes.io/name":      "aim-endpoint",
 			"app.kubernetes.io/instance":  endpoint.Name,
 			"app.kubernetes.io/component": "server",
 		}
-		
+
 		deployment.OwnerReferences = []metav1.OwnerReference{
 			*metav1.NewControllerRef(endpoint, aimv1alpha1.GroupVersion.WithKind("AIMEndpoint")),
 		}
-		
+
 		// Set replicas
 		replicas := int32(1)
 		if endpoint.Spec.Scaling.MinReplicas != nil {
 			replicas = *endpoint.Spec.Scaling.MinReplicas
 		}
 		deployment.Spec.Replicas = &replicas
-		
+
 		// Set selector
 		deployment.Spec.Selector = &metav1.LabelSelector{
 			MatchLabels: map[string]string{
@@ -388,10 +685,10 @@ func (r *AIMEndpointReconciler) reconcileDeployment(ctx context.Context, endpoin
 				"app.kubernetes.io/instance": endpoint.Name,
 			},
 		}
-		
+
 		// Set template
 		deployment.Spec.Template.ObjectMeta.Labels = deployment.Spec.Selector.MatchLabels
-		
+
 		// Set containers
 		container := corev1.Container{
 			Name:  "aim-server",
@@ -406,7 +703,7 @@ func (r *AIMEndpointReconciler) reconcileDeployment(ctx context.Context, endpoin
 			Resources: r.getResourceRequirements(endpoint),
 			Env:       r.getEnvironmentVariables(endpoint),
 		}
-		
+
 		// Add volume mounts if caching is enabled
 		if endpoint.Spec.Cache.Enabled != nil && *endpoint.Spec.Cache.Enabled {
 			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
@@ -414,27 +711,32 @@ func (r *AIMEndpointReconciler) reconcileDeployment(ctx context.Context, endpoin
 				MountPath: "/workspace/model-cache",
 			})
 		}
-		
-		deployment.Spec.Template.Spec.Containers = []corev1.Container{container}
-		
+
+		containers := []corev1.Container{container}
+		containers = append(containers, r.getPredictorStageContainers(endpoint)...)
+		deployment.Spec.Template.Spec.Containers = containers
+
+		// Apply GPU topology constraints as node affinity
+		deployment.Spec.Template.Spec.Affinity = r.getTopologyAffinity(endpoint)
+
 		// Initialize volumes slice
 		deployment.Spec.Template.Spec.Volumes = []corev1.Volume{}
-		
+
 		// Add volumes if caching is enabled
 		if endpoint.Spec.Cache.Enabled != nil && *endpoint.Spec.Cache.Enabled {
 			deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
 				Name: "model-cache",
 				VolumeSource: corev1.VolumeSource{
 					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-						ClaimName: fmt.Sprintf("%s-cache", endpoint.Name),
+						ClaimName: r.cacheClaimName(endpoint),
 					},
 				},
 			})
 		}
-		
+
 		return nil
 	})
-	
+
 	return err
 }
 
@@ -446,18 +748,18 @@ func (r *AIMEndpointReconciler) reconcileService(ctx context.Context, endpoint *
 			Namespace: endpoint.Namespace,
 		},
 	}
-	
+
 	_, err := ctrl.CreateOrUpdate(ctx, r.Client, service, func() error {
 		service.Labels = map[string]string{
 			"app.kubernetes.io/name":      "aim-endpoint",
 			"app.kubernetes.io/instance":  endpoint.Name,
 			"app.kubernetes.io/component": "service",
 		}
-		
+
 		service.OwnerReferences = []metav1.OwnerReference{
 			*metav1.NewControllerRef(endpoint, aimv1alpha1.GroupVersion.WithKind("AIMEndpoint")),
 		}
-		
+
 		service.Spec = corev1.ServiceSpec{
 			Selector: map[string]string{
 				"app.kubernetes.io/name":     "aim-endpoint",
@@ -472,20 +774,30 @@ func (r *AIMEndpointReconciler) reconcileService(ctx context.Context, endpoint *
 				},
 			},
 		}
-		
+
+		// Expose the KServe-style /v2/models/{name}/infer port alongside the default service
+		if endpoint.Spec.InferenceProtocol != nil && endpoint.Spec.InferenceProtocol.Protocol != "" {
+			service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+				Name:       "v2",
+				Port:       8001,
+				TargetPort: intstr.FromInt(8001),
+				Protocol:   corev1.ProtocolTCP,
+			})
+		}
+
 		// Set service type
 		if endpoint.Spec.Service.Type != "" {
 			service.Spec.Type = corev1.ServiceType(endpoint.Spec.Service.Type)
 		}
-		
+
 		// Set annotations
 		if endpoint.Spec.Service.Annotations != nil {
 			service.Annotations = endpoint.Spec.Service.Annotations
 		}
-		
+
 		return nil
 	})
-	
+
 	return err
 }
 
@@ -497,18 +809,18 @@ func (r *AIMEndpointReconciler) reconcileHPA(ctx context.Context, endpoint *aimv
 			Namespace: endpoint.Namespace,
 		},
 	}
-	
+
 	_, err := ctrl.CreateOrUpdate(ctx, r.Client, hpa, func() error {
 		hpa.Labels = map[string]string{
 			"app.kubernetes.io/name":      "aim-endpoint",
 			"app.kubernetes.io/instance":  endpoint.Name,
 			"app.kubernetes.io/component": "autoscaler",
 		}
-		
+
 		hpa.OwnerReferences = []metav1.OwnerReference{
 			*metav1.NewControllerRef(endpoint, aimv1alpha1.GroupVersion.WithKind("AIMEndpoint")),
 		}
-		
+
 		hpa.Spec = autoscalingv2.HorizontalPodAutoscalerSpec{
 			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
 				APIVersion: "apps/v1",
@@ -518,10 +830,10 @@ func (r *AIMEndpointReconciler) reconcileHPA(ctx context.Context, endpoint *aimv
 			MinReplicas: endpoint.Spec.Scaling.MinReplicas,
 			MaxReplicas: *endpoint.Spec.Scaling.MaxReplicas,
 		}
-		
+
 		// Add metrics
 		var metrics []autoscalingv2.MetricSpec
-		
+
 		if endpoint.Spec.Scaling.TargetCPUUtilization != nil {
 			metrics = append(metrics, autoscalingv2.MetricSpec{
 				Type: autoscalingv2.ResourceMetricSourceType,
@@ -534,7 +846,7 @@ func (r *AIMEndpointReconciler) reconcileHPA(ctx context.Context, endpoint *aimv
 				},
 			})
 		}
-		
+
 		if endpoint.Spec.Scaling.TargetMemoryUtilization != nil {
 			metrics = append(metrics, autoscalingv2.MetricSpec{
 				Type: autoscalingv2.ResourceMetricSourceType,
@@ -547,15 +859,151 @@ func (r *AIMEndpointReconciler) reconcileHPA(ctx context.Context, endpoint *aimv
 				},
 			})
 		}
-		
+
+		for _, metric := range endpoint.Spec.Scaling.Metrics {
+			spec, err := inferenceMetricSpec(metric)
+			if err != nil {
+				return err
+			}
+			metrics = append(metrics, spec)
+		}
+
 		hpa.Spec.Metrics = metrics
-		
+
 		return nil
 	})
-	
+
 	return err
 }
 
+// inferenceMetricSpec translates a single ScalingMetric into the
+// autoscalingv2.MetricSpec variant that matches how its value is actually
+// exposed: RequestQueue and TTFT are scraped per-pod by the Prometheus
+// adapter, while GPUUtilization comes from the amd.com/gpu-labeled node
+// exporter and is only available as an external metric.
+func inferenceMetricSpec(metric aimv1alpha1.ScalingMetric) (autoscalingv2.MetricSpec, error) {
+	switch metric.Type {
+	case aimv1alpha1.MetricTypeRequestQueue:
+		if metric.Target == nil {
+			return autoscalingv2.MetricSpec{}, fmt.Errorf("metric %s requires target", metric.Type)
+		}
+		return autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: "vllm:num_requests_waiting"},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: resource.NewQuantity(int64(*metric.Target), resource.DecimalSI),
+				},
+			},
+		}, nil
+
+	case aimv1alpha1.MetricTypeGPUUtilization:
+		if metric.Target == nil {
+			return autoscalingv2.MetricSpec{}, fmt.Errorf("metric %s requires target", metric.Type)
+		}
+		return autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ExternalMetricSourceType,
+			External: &autoscalingv2.ExternalMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{
+					Name:     "amd_gpu_utilization",
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"amd.com/gpu": "true"}},
+				},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: resource.NewQuantity(int64(*metric.Target), resource.DecimalSI),
+				},
+			},
+		}, nil
+
+	case aimv1alpha1.MetricTypeTTFT:
+		if metric.TargetMs == nil {
+			return autoscalingv2.MetricSpec{}, fmt.Errorf("metric %s requires targetMs", metric.Type)
+		}
+		return autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: "time_to_first_token_p95_ms"},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: resource.NewQuantity(int64(*metric.TargetMs), resource.DecimalSI),
+				},
+			},
+		}, nil
+
+	default:
+		return autoscalingv2.MetricSpec{}, fmt.Errorf("unknown scaling metric type: %s", metric.Type)
+	}
+}
+
+// reconcileVPA creates or updates a VerticalPodAutoscaler targeting the endpoint's
+// Deployment, mirroring reconcileHPA's shape. The amd.com/gpu resource is left out
+// of ControlledResources so the VPA only ever recommends/rewrites CPU and memory;
+// GPU count remains a recipe-selection decision.
+func (r *AIMEndpointReconciler) reconcileVPA(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
+	vpa := &vpav1.VerticalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      endpoint.Name,
+			Namespace: endpoint.Namespace,
+		},
+	}
+
+	_, err := ctrl.CreateOrUpdate(ctx, r.Client, vpa, func() error {
+		vpa.Labels = map[string]string{
+			"app.kubernetes.io/name":      "aim-endpoint",
+			"app.kubernetes.io/instance":  endpoint.Name,
+			"app.kubernetes.io/component": "autoscaler",
+		}
+
+		vpa.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(endpoint, aimv1alpha1.GroupVersion.WithKind("AIMEndpoint")),
+		}
+
+		updateMode := vpav1.UpdateModeAuto
+		if endpoint.Spec.Scaling.VPA.UpdateMode != "" {
+			updateMode = vpav1.UpdateMode(endpoint.Spec.Scaling.VPA.UpdateMode)
+		}
+
+		controlledResources := []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+		vpa.Spec = vpav1.VerticalPodAutoscalerSpec{
+			TargetRef: &autoscalingv1.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       endpoint.Name,
+			},
+			UpdatePolicy: &vpav1.PodUpdatePolicy{
+				UpdateMode: &updateMode,
+			},
+			ResourcePolicy: &vpav1.PodResourcePolicy{
+				ContainerPolicies: []vpav1.ContainerResourcePolicy{
+					{
+						ContainerName:       "*",
+						ControlledResources: &controlledResources,
+					},
+				},
+			},
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+// cleanupVPA removes the VerticalPodAutoscaler reconcileVPA may have
+// previously created for endpoint. It is a no-op if none exists, so it's
+// safe to call unconditionally whenever Scaling.VPA is disabled or absent.
+func (r *AIMEndpointReconciler) cleanupVPA(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
+	vpa := &vpav1.VerticalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: endpoint.Name, Namespace: endpoint.Namespace},
+	}
+	if err := r.Delete(ctx, vpa); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 // reconcileMonitoring creates or updates monitoring resources
 func (r *AIMEndpointReconciler) reconcileMonitoring(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
 	// This would create ServiceMonitor and PrometheusRule resources
@@ -564,81 +1012,166 @@ func (r *AIMEndpointReconciler) reconcileMonitoring(ctx context.Context, endpoin
 }
 
 // updateStatus updates the endpoint status
-func (r *AIMEndpointReconciler) updateStatus(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
-	// Get deployment status
-	deployment := &appsv1.Deployment{}
-	err := r.Get(ctx, types.NamespacedName{Name: endpoint.Name, Namespace: endpoint.Namespace}, deployment)
-	if err != nil && !errors.IsNotFound(err) {
-		return err
+// updateStatus computes readiness for every resource the endpoint owns via
+// pkg/statuscheck (the same Deployment/Pod/PVC/Service rules Helm 3.5+ and
+// kstatus use) and publishes Progressing/Available/ResourcesReady/ModelLoaded
+// conditions from the result, instead of reading ReadyReplicas alone.
+func (r *AIMEndpointReconciler) updateStatus(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) (ctrl.Result, error) {
+	workload, err := r.getWorkloadStatus(ctx, endpoint)
+	if err != nil {
+		return ctrl.Result{}, err
 	}
-	
-	// Get service status
+
 	service := &corev1.Service{}
-	err = r.Get(ctx, types.NamespacedName{Name: endpoint.Name, Namespace: endpoint.Namespace}, service)
-	if err != nil && !errors.IsNotFound(err) {
-		return err
+	if err := r.Get(ctx, types.NamespacedName{Name: endpoint.Name, Namespace: endpoint.Namespace}, service); err != nil && !errors.IsNotFound(err) {
+		return ctrl.Result{}, err
 	}
-	
-	// Update status
+
+	var results []statuscheck.Result
+	results = append(results, workload.result)
+	results = append(results, statuscheck.Service(service))
+
+	if endpoint.Spec.Cache.Enabled != nil && *endpoint.Spec.Cache.Enabled && endpoint.Spec.Cache.CacheRef == nil {
+		pvc := &corev1.PersistentVolumeClaim{}
+		if err := r.Get(ctx, types.NamespacedName{Name: r.cacheClaimName(endpoint), Namespace: endpoint.Namespace}, pvc); err == nil {
+			results = append(results, statuscheck.PVC(pvc))
+		} else if !errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(endpoint.Namespace), client.MatchingLabels{"app.kubernetes.io/instance": endpoint.Name}); err != nil {
+		return ctrl.Result{}, err
+	}
+	for i := range pods.Items {
+		results = append(results, statuscheck.Pod(&pods.Items[i]))
+	}
+
+	aggregate := statuscheck.Aggregate(results)
+
 	endpoint.Status.ObservedGeneration = endpoint.Generation
-	
-	if deployment.Status.ReadyReplicas > 0 {
+
+	progressing := aggregate.Status != statuscheck.StatusCurrent
+	meta.SetStatusCondition(&endpoint.Status.Conditions, metav1.Condition{
+		Type:               aimv1alpha1.ConditionProgressing,
+		Status:             boolToConditionStatus(progressing),
+		Reason:             aggregate.Reason,
+		Message:            aggregate.Message,
+		ObservedGeneration: endpoint.Generation,
+	})
+	meta.SetStatusCondition(&endpoint.Status.Conditions, metav1.Condition{
+		Type:               aimv1alpha1.ConditionAvailable,
+		Status:             boolToConditionStatus(workload.result.Status == statuscheck.StatusCurrent),
+		Reason:             workload.result.Reason,
+		Message:            workload.result.Message,
+		ObservedGeneration: endpoint.Generation,
+	})
+	meta.SetStatusCondition(&endpoint.Status.Conditions, metav1.Condition{
+		Type:               aimv1alpha1.ConditionResourcesReady,
+		Status:             boolToConditionStatus(aggregate.Status == statuscheck.StatusCurrent),
+		Reason:             aggregate.Reason,
+		Message:            aggregate.Message,
+		ObservedGeneration: endpoint.Generation,
+	})
+	meta.SetStatusCondition(&endpoint.Status.Conditions, metav1.Condition{
+		Type:               aimv1alpha1.ConditionModelLoaded,
+		Status:             boolToConditionStatus(workload.result.Status == statuscheck.StatusCurrent && len(pods.Items) > 0),
+		Reason:             workload.result.Reason,
+		Message:            "model is loaded once the workload's pods are ready",
+		ObservedGeneration: endpoint.Generation,
+	})
+
+	switch aggregate.Status {
+	case statuscheck.StatusCurrent:
 		endpoint.Status.Phase = "Ready"
-		endpoint.Status.Conditions = []metav1.Condition{
-			{
-				Type:               "Ready",
-				Status:             metav1.ConditionTrue,
-				Reason:             "DeploymentReady",
-				Message:            "AIMEndpoint is ready",
-				LastTransitionTime: metav1.Now(),
-			},
-		}
-	} else {
+	case statuscheck.StatusFailed:
+		endpoint.Status.Phase = "Failed"
+	default:
 		endpoint.Status.Phase = "Pending"
-		endpoint.Status.Conditions = []metav1.Condition{
-			{
-				Type:               "Ready",
-				Status:             metav1.ConditionFalse,
-				Reason:             "DeploymentNotReady",
-				Message:            "Deployment is not ready",
-				LastTransitionTime: metav1.Now(),
-			},
-		}
 	}
-	
+
 	// Update replica status
-	if deployment.Status.Replicas > 0 {
+	if workload.current != nil {
 		endpoint.Status.Replicas = &aimv1alpha1.ReplicaStatus{
-			Current:   &deployment.Status.Replicas,
-			Desired:   deployment.Spec.Replicas,
-			Ready:     &deployment.Status.ReadyReplicas,
-			Available: &deployment.Status.AvailableReplicas,
+			Current:   workload.current,
+			Desired:   workload.desired,
+			Ready:     workload.ready,
+			Available: workload.avail,
 		}
 	}
-	
+
 	// Update endpoint status
 	if service.Spec.ClusterIP != "" {
 		endpoint.Status.Endpoints = &aimv1alpha1.EndpointStatus{
 			Internal: fmt.Sprintf("%s.%s.svc.cluster.local:8000", service.Name, service.Namespace),
 		}
-		
-		if service.Spec.Type == corev1.ServiceTypeLoadBalancer {
-			// This would be updated when LoadBalancer IP is assigned
+
+		if service.Spec.Type == corev1.ServiceTypeLoadBalancer && len(service.Status.LoadBalancer.Ingress) > 0 {
 			endpoint.Status.Endpoints.LoadBalancer = service.Status.LoadBalancer.Ingress[0].IP
 		}
 	}
-	
-	return r.Status().Update(ctx, endpoint)
+
+	if err := r.Status().Update(ctx, endpoint); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !progressing {
+		return ctrl.Result{RequeueAfter: time.Minute * 5}, nil
+	}
+
+	return ctrl.Result{RequeueAfter: progressingBackoff(meta.FindStatusCondition(endpoint.Status.Conditions, aimv1alpha1.ConditionProgressing))}, nil
+}
+
+// boolToConditionStatus converts "is this condition's underlying problem true" into
+// the metav1.ConditionStatus for that condition type.
+func boolToConditionStatus(conditionTrue bool) metav1.ConditionStatus {
+	if conditionTrue {
+		return metav1.ConditionTrue
+	}
+	return metav1.ConditionFalse
+}
+
+// progressingBackoff grows the requeue interval the longer a resource has been
+// stuck in Progressing, instead of hot-looping every reconcile at a flat interval.
+func progressingBackoff(progressing *metav1.Condition) time.Duration {
+	if progressing == nil {
+		return 15 * time.Second
+	}
+
+	switch elapsed := time.Since(progressing.LastTransitionTime.Time); {
+	case elapsed < 30*time.Second:
+		return 5 * time.Second
+	case elapsed < 2*time.Minute:
+		return 15 * time.Second
+	case elapsed < 5*time.Minute:
+		return 30 * time.Second
+	default:
+		return time.Minute
+	}
 }
 
 // handleDeletion handles the deletion of the endpoint
 func (r *AIMEndpointReconciler) handleDeletion(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) (ctrl.Result, error) {
+	// Release every AIMCacheBinding this endpoint holds before removing
+	// itself, so AIMCacheStatus.ReferencedBy updates synchronously instead of
+	// waiting on owner-reference garbage collection.
+	bindings, err := r.listCacheBindings(ctx, endpoint)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	for i := range bindings {
+		if err := r.Delete(ctx, &bindings[i]); err != nil && !errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Remove finalizer
 	endpoint.Finalizers = removeString(endpoint.Finalizers, "aimendpoint.aim.engine.amd.com/finalizer")
 	if err := r.Update(ctx, endpoint); err != nil {
 		return ctrl.Result{}, err
 	}
-	
+
 	return ctrl.Result{}, nil
 }
 
@@ -651,40 +1184,40 @@ func (r *AIMEndpointReconciler) getImage(endpoint *aimv1alpha1.AIMEndpoint) stri
 		}
 		return fmt.Sprintf("%s:%s", endpoint.Spec.Image.Repository, tag)
 	}
-	
+
 	// Default image based on backend
 	backend := "vllm"
 	if endpoint.Status.SelectedRecipe != nil {
 		backend = endpoint.Status.SelectedRecipe.Backend
 	}
-	
+
 	return fmt.Sprintf("ghcr.io/aim-engine/%s-server:latest", backend)
 }
 
 func (r *AIMEndpointReconciler) getResourceRequirements(endpoint *aimv1alpha1.AIMEndpoint) corev1.ResourceRequirements {
 	requests := corev1.ResourceList{}
 	limits := corev1.ResourceList{}
-	
+
 	// Set GPU requirements
 	if endpoint.Status.SelectedRecipe != nil && endpoint.Status.SelectedRecipe.GPUCount != nil {
 		requests["amd.com/gpu"] = resource.MustParse(strconv.Itoa(int(*endpoint.Status.SelectedRecipe.GPUCount)))
 		limits["amd.com/gpu"] = resource.MustParse(strconv.Itoa(int(*endpoint.Status.SelectedRecipe.GPUCount)))
 	}
-	
+
 	// Set CPU and memory requirements
-	if endpoint.Spec.Resources.CPU != "" {
-		requests[corev1.ResourceCPU] = resource.MustParse(endpoint.Spec.Resources.CPU)
+	if endpoint.Spec.Resources.CPU != nil && !endpoint.Spec.Resources.CPU.IsZero() {
+		requests[corev1.ResourceCPU] = *endpoint.Spec.Resources.CPU
 	}
-	if endpoint.Spec.Resources.Memory != "" {
-		requests[corev1.ResourceMemory] = resource.MustParse(endpoint.Spec.Resources.Memory)
+	if endpoint.Spec.Resources.Memory != nil && !endpoint.Spec.Resources.Memory.IsZero() {
+		requests[corev1.ResourceMemory] = *endpoint.Spec.Resources.Memory
 	}
-	if endpoint.Spec.Resources.CPULimit != "" {
-		limits[corev1.ResourceCPU] = resource.MustParse(endpoint.Spec.Resources.CPULimit)
+	if endpoint.Spec.Resources.CPULimit != nil && !endpoint.Spec.Resources.CPULimit.IsZero() {
+		limits[corev1.ResourceCPU] = *endpoint.Spec.Resources.CPULimit
 	}
-	if endpoint.Spec.Resources.MemoryLimit != "" {
-		limits[corev1.ResourceMemory] = resource.MustParse(endpoint.Spec.Resources.MemoryLimit)
+	if endpoint.Spec.Resources.MemoryLimit != nil && !endpoint.Spec.Resources.MemoryLimit.IsZero() {
+		limits[corev1.ResourceMemory] = *endpoint.Spec.Resources.MemoryLimit
 	}
-	
+
 	return corev1.ResourceRequirements{
 		Requests: requests,
 		Limits:   limits,
@@ -698,24 +1231,130 @@ func (r *AIMEndpointReconciler) getEnvironmentVariables(endpoint *aimv1alpha1.AI
 			Value: endpoint.Spec.Model.ID,
 		},
 	}
-	
+
 	if endpoint.Spec.Model.Version != "" {
 		envVars = append(envVars, corev1.EnvVar{
 			Name:  "MODEL_VERSION",
 			Value: endpoint.Spec.Model.Version,
 		})
 	}
-	
+
 	if endpoint.Status.SelectedRecipe != nil {
 		envVars = append(envVars, corev1.EnvVar{
 			Name:  "PRECISION",
 			Value: endpoint.Status.SelectedRecipe.Precision,
 		})
 	}
-	
+
 	return envVars
 }
 
+// cacheClaimName returns the PVC claim to mount for model caching: the shared
+// cache referenced via Cache.CacheRef when set, otherwise the endpoint's own
+// per-endpoint cache PVC
+func (r *AIMEndpointReconciler) cacheClaimName(endpoint *aimv1alpha1.AIMEndpoint) string {
+	if endpoint.Spec.Cache.CacheRef != nil {
+		return fmt.Sprintf("%s-shared-cache", endpoint.Spec.Cache.CacheRef.Name)
+	}
+	return fmt.Sprintf("%s-cache", endpoint.Name)
+}
+
+// getTopologyAffinity translates Resources.Topology into node affinity that
+// steers scheduling toward nodes matching the requested GPU model and
+// interconnect. NUMA affinity and MIG partitioning are applied by the
+// topology-aware device plugin based on the pod's requested resource names.
+func (r *AIMEndpointReconciler) getTopologyAffinity(endpoint *aimv1alpha1.AIMEndpoint) *corev1.Affinity {
+	topology := endpoint.Spec.Resources.Topology
+	if topology == nil {
+		return nil
+	}
+
+	var requirements []corev1.NodeSelectorRequirement
+	if topology.GPUModel != "" {
+		requirements = append(requirements, corev1.NodeSelectorRequirement{
+			Key:      "amd.com/gpu.product",
+			Operator: corev1.NodeSelectorOpIn,
+			Values:   []string{topology.GPUModel},
+		})
+	}
+	if topology.Interconnect != "" {
+		requirements = append(requirements, corev1.NodeSelectorRequirement{
+			Key:      "amd.com/gpu.interconnect",
+			Operator: corev1.NodeSelectorOpIn,
+			Values:   []string{topology.Interconnect},
+		})
+	}
+
+	if len(requirements) == 0 {
+		return nil
+	}
+
+	return &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{MatchExpressions: requirements},
+				},
+			},
+		},
+	}
+}
+
+// convertResourceRequirements converts the API's string-keyed resource
+// requirements into the typed corev1 equivalent
+func convertResourceRequirements(req aimv1alpha1.ResourceRequirements) corev1.ResourceRequirements {
+	requests := corev1.ResourceList{}
+	limits := corev1.ResourceList{}
+
+	for name, qty := range req.Requests {
+		requests[corev1.ResourceName(name)] = qty
+	}
+	for name, qty := range req.Limits {
+		limits[corev1.ResourceName(name)] = qty
+	}
+
+	return corev1.ResourceRequirements{Requests: requests, Limits: limits}
+}
+
+// getPredictorStageContainers builds the transformer/explainer sidecar containers
+// that sit in front of and behind the predictor (the aim-server container) when
+// an InferenceProtocol predictor graph is configured.
+func (r *AIMEndpointReconciler) getPredictorStageContainers(endpoint *aimv1alpha1.AIMEndpoint) []corev1.Container {
+	if endpoint.Spec.InferenceProtocol == nil || endpoint.Spec.InferenceProtocol.Predictor == nil {
+		return nil
+	}
+
+	var containers []corev1.Container
+	predictor := endpoint.Spec.InferenceProtocol.Predictor
+
+	if predictor.Transformer != nil {
+		containers = append(containers, r.buildStageContainer("transformer", predictor.Transformer))
+	}
+	if predictor.Explainer != nil {
+		containers = append(containers, r.buildStageContainer("explainer", predictor.Explainer))
+	}
+
+	return containers
+}
+
+// buildStageContainer converts an InferenceStageSpec into a corev1.Container
+func (r *AIMEndpointReconciler) buildStageContainer(name string, stage *aimv1alpha1.InferenceStageSpec) corev1.Container {
+	container := corev1.Container{
+		Name:  name,
+		Image: stage.Image,
+	}
+
+	if stage.Resources != nil {
+		container.Resources = convertResourceRequirements(*stage.Resources)
+	}
+
+	for _, env := range stage.Env {
+		container.Env = append(container.Env, corev1.EnvVar{Name: env.Name, Value: env.Value})
+	}
+
+	return container
+}
+
 func containsString(slice []string, str string) bool {
 	for _, item := range slice {
 		if item == str {
@@ -732,4 +1371,4 @@ func removeString(slice []string, str string) []string {
 		}
 	}
 	return slice
-} 
\ No newline at end of file
+}
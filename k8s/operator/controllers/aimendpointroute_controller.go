@@ -0,0 +1,274 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	aimv1alpha1 "github.com/aim-engine/operator/api/v1alpha1"
+)
+
+// AIMEndpointRouteReconciler reconciles a AIMEndpointRoute object.
+//
+// It computes the route's desired traffic split (Status.ObservedSplit) and
+// progresses Status.RolloutPhase through Spec.Rollout.Steps, gated on the
+// rollout target backend's own AIMEndpoint metrics. This operator has no
+// service mesh or gateway integration of its own (there's no Istio/Envoy/
+// Gateway API dependency anywhere else in this codebase), so it does not
+// itself proxy or shape request traffic -- that's left to whatever ingress
+// or data-plane component a cluster operator points at this route's status,
+// the same way AIMEndpointReconciler's HPA/VPA reconcilers manage the
+// autoscaling object while the metrics-server/VPA admission webhook do the
+// actual enforcement elsewhere in the cluster.
+type AIMEndpointRouteReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+//+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimendpointroutes,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimendpointroutes/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimendpoints,verbs=get;list;watch
+
+// Reconcile resolves the route's backends, advances its rollout (if any),
+// and records the resulting observed split and rollout phase in status.
+func (r *AIMEndpointRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	route := &aimv1alpha1.AIMEndpointRoute{}
+	if err := r.Get(ctx, req.NamespacedName, route); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	backends, err := r.resolveBackends(ctx, route)
+	if err != nil {
+		logger.Error(err, "Failed to resolve AIMEndpointRoute backends")
+		route.Status.Phase = "BackendNotFound"
+		route.Status.ObservedGeneration = route.Generation
+		meta.SetStatusCondition(&route.Status.Conditions, metav1.Condition{
+			Type:               aimv1alpha1.ConditionValid,
+			Status:             metav1.ConditionFalse,
+			Reason:             aimv1alpha1.ReasonValidationFailed,
+			Message:            err.Error(),
+			ObservedGeneration: route.Generation,
+		})
+		r.Status().Update(ctx, route)
+		return ctrl.Result{RequeueAfter: time.Minute * 5}, nil
+	}
+
+	progressing := false
+	if route.Spec.Rollout != nil && len(route.Spec.Rollout.Steps) > 0 {
+		lastIdx := len(backends) - 1
+		route.Status.RolloutPhase, progressing = r.advanceRollout(route.Status.RolloutPhase, route.Spec.Rollout, backends[lastIdx].endpoint)
+		backends[lastIdx].weight = route.Status.RolloutPhase.CurrentWeight
+		for name, weight := range normalizeWeights(backends[:lastIdx], 100-backends[lastIdx].weight) {
+			backends[indexOfBackend(backends, name)].weight = weight
+		}
+	} else {
+		route.Status.RolloutPhase = nil
+		for name, weight := range normalizeWeights(backends, 100) {
+			backends[indexOfBackend(backends, name)].weight = weight
+		}
+	}
+
+	split := map[string]int32{}
+	for _, b := range backends {
+		split[b.name] = b.weight
+	}
+	route.Status.ObservedSplit = split
+	route.Status.ObservedGeneration = route.Generation
+
+	route.Status.Phase = "Ready"
+	if progressing {
+		route.Status.Phase = "RollingOut"
+	}
+	meta.SetStatusCondition(&route.Status.Conditions, metav1.Condition{
+		Type:               aimv1alpha1.ConditionValid,
+		Status:             metav1.ConditionTrue,
+		Reason:             aimv1alpha1.ReasonValidationSucceeded,
+		Message:            "All backends resolved",
+		ObservedGeneration: route.Generation,
+	})
+	meta.SetStatusCondition(&route.Status.Conditions, metav1.Condition{
+		Type:               aimv1alpha1.ConditionProgressing,
+		Status:             boolToConditionStatus(progressing),
+		Reason:             aimv1alpha1.ReasonReconcileSucceeded,
+		Message:            fmt.Sprintf("Observed split: %v", split),
+		ObservedGeneration: route.Generation,
+	})
+
+	if err := r.Status().Update(ctx, route); err != nil {
+		logger.Error(err, "Failed to update AIMEndpointRoute status")
+		return ctrl.Result{}, err
+	}
+
+	requeue := time.Minute * 10
+	if progressing {
+		requeue = time.Second * 30
+	}
+	return ctrl.Result{RequeueAfter: requeue}, nil
+}
+
+// resolvedBackend pairs a RouteBackend with the AIMEndpoint it resolved to
+// and the weight this reconcile assigns it.
+type resolvedBackend struct {
+	name       string
+	specWeight *int32
+	weight     int32
+	endpoint   *aimv1alpha1.AIMEndpoint
+}
+
+// resolveBackends fetches the AIMEndpoint named by each backend's
+// EndpointRef in route's own namespace, erroring out on the first one that
+// doesn't exist rather than silently dropping it from the split.
+func (r *AIMEndpointRouteReconciler) resolveBackends(ctx context.Context, route *aimv1alpha1.AIMEndpointRoute) ([]resolvedBackend, error) {
+	backends := make([]resolvedBackend, 0, len(route.Spec.Backends))
+	for _, b := range route.Spec.Backends {
+		endpoint := &aimv1alpha1.AIMEndpoint{}
+		key := types.NamespacedName{Namespace: route.Namespace, Name: b.EndpointRef.Name}
+		if err := r.Get(ctx, key, endpoint); err != nil {
+			return nil, fmt.Errorf("backend %s: %w", b.EndpointRef.Name, err)
+		}
+		backends = append(backends, resolvedBackend{name: b.EndpointRef.Name, specWeight: b.Weight, endpoint: endpoint})
+	}
+	return backends, nil
+}
+
+// advanceRollout steps prev forward through rollout.Steps, dwelling on each
+// step for DwellTime and only advancing once rollout.SuccessCriteria is met
+// against target's own metrics. progressing is true while a later step
+// remains and turns false as soon as the last step is reached; the last
+// step's DwellTime is not waited out.
+func (r *AIMEndpointRouteReconciler) advanceRollout(prev *aimv1alpha1.RouteRolloutStatus, rollout *aimv1alpha1.RouteRolloutSpec, target *aimv1alpha1.AIMEndpoint) (*aimv1alpha1.RouteRolloutStatus, bool) {
+	steps := rollout.Steps
+	now := metav1.Now()
+
+	if prev == nil {
+		return &aimv1alpha1.RouteRolloutStatus{CurrentStep: 0, CurrentWeight: steps[0].TargetWeight, StepStartedAt: &now}, len(steps) > 1
+	}
+
+	idx := prev.CurrentStep
+	if idx >= int32(len(steps)) {
+		idx = int32(len(steps)) - 1
+	}
+	if idx == int32(len(steps)-1) {
+		// Already on the last step; nothing left to advance to.
+		return &aimv1alpha1.RouteRolloutStatus{CurrentStep: idx, CurrentWeight: steps[idx].TargetWeight, StepStartedAt: prev.StepStartedAt}, false
+	}
+
+	dwelled := prev.StepStartedAt == nil
+	if prev.StepStartedAt != nil {
+		dwell, err := time.ParseDuration(steps[idx].DwellTime)
+		if err != nil {
+			dwell = 0
+		}
+		dwelled = now.Sub(prev.StepStartedAt.Time) >= dwell
+	}
+
+	if dwelled && rolloutSuccessCriteriaMet(rollout.SuccessCriteria, target) {
+		idx++
+		return &aimv1alpha1.RouteRolloutStatus{CurrentStep: idx, CurrentWeight: steps[idx].TargetWeight, StepStartedAt: &now}, idx < int32(len(steps)-1)
+	}
+
+	return &aimv1alpha1.RouteRolloutStatus{CurrentStep: idx, CurrentWeight: steps[idx].TargetWeight, StepStartedAt: prev.StepStartedAt}, true
+}
+
+// rolloutSuccessCriteriaMet reports whether target's observed metrics clear
+// criteria's gates. MaxErrorRate can't be evaluated here: AIMEndpointStatus
+// has no error-rate metric anywhere in this operator, so it's treated as
+// always satisfied rather than blocking a rollout forever on a criterion
+// nothing can ever report.
+func rolloutSuccessCriteriaMet(criteria *aimv1alpha1.RolloutSuccessCriteria, target *aimv1alpha1.AIMEndpoint) bool {
+	if criteria == nil || criteria.MaxLatencyMs == nil {
+		return true
+	}
+	if target.Status.Metrics == nil || target.Status.Metrics.Latency == nil {
+		return false
+	}
+	return *target.Status.Metrics.Latency <= *criteria.MaxLatencyMs
+}
+
+// normalizeWeights splits total across backends: explicit Spec.Weight values
+// are honored as-is, and any remainder is divided evenly among the backends
+// that didn't specify one (the last such backend absorbs the rounding
+// remainder). Explicit weights are not rescaled, so the split sums to total
+// only when some backend is unweighted and the explicit weights don't exceed total.
+func normalizeWeights(backends []resolvedBackend, total int32) map[string]int32 {
+	result := map[string]int32{}
+	if len(backends) == 0 {
+		return result
+	}
+
+	explicitSum := int32(0)
+	var unweighted []string
+	for _, b := range backends {
+		if b.specWeight != nil {
+			result[b.name] = *b.specWeight
+			explicitSum += *b.specWeight
+		} else {
+			unweighted = append(unweighted, b.name)
+		}
+	}
+
+	if len(unweighted) == 0 {
+		return result
+	}
+
+	remaining := total - explicitSum
+	if remaining < 0 {
+		remaining = 0
+	}
+	share := remaining / int32(len(unweighted))
+	for i, name := range unweighted {
+		w := share
+		if i == len(unweighted)-1 {
+			w = remaining - share*int32(len(unweighted)-1)
+		}
+		result[name] = w
+	}
+	return result
+}
+
+// indexOfBackend returns the index of the backend named name, or -1 if absent.
+func indexOfBackend(backends []resolvedBackend, name string) int {
+	for i, b := range backends {
+		if b.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AIMEndpointRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&aimv1alpha1.AIMEndpointRoute{}).
+		Complete(r)
+}
@@ -0,0 +1,316 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	aimv1alpha1 "github.com/aim-engine/operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// AIMModelCacheReconciler reconciles a AIMModelCache object.
+//
+// Deprecated: AIMModelCache predates AIMCache's multi-model, multi-tier
+// storage (see AIMCacheReconciler) and only ever warms a single model into a
+// single shared PVC. New integrations should use AIMCache/AIMCacheSpec.Models
+// instead; this reconciler is kept working for existing AIMModelCache
+// objects but isn't being extended further.
+type AIMModelCacheReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// PullerImage overrides the image the warm-up Job runs to fetch
+	// Spec.Model, configurable via the manager's --model-puller-image flag.
+	// Defaults to defaultPullerImage when unset.
+	PullerImage string
+}
+
+// pullerImage returns r.PullerImage, or defaultPullerImage if unset.
+func (r *AIMModelCacheReconciler) pullerImage() string {
+	if r.PullerImage != "" {
+		return r.PullerImage
+	}
+	return defaultPullerImage
+}
+
+//+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimmodelcaches,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimmodelcaches/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimmodelcaches/finalizers,verbs=update
+//+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimendpoints,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *AIMModelCacheReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	modelCache := &aimv1alpha1.AIMModelCache{}
+	if err := r.Get(ctx, req.NamespacedName, modelCache); err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("AIMModelCache resource not found. Ignoring since object must be deleted")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get AIMModelCache")
+		return ctrl.Result{}, err
+	}
+
+	if !modelCache.DeletionTimestamp.IsZero() {
+		return r.handleDeletion(ctx, modelCache)
+	}
+
+	if !containsString(modelCache.Finalizers, "aimmodelcache.aim.engine.amd.com/finalizer") {
+		modelCache.Finalizers = append(modelCache.Finalizers, "aimmodelcache.aim.engine.amd.com/finalizer")
+		if err := r.Update(ctx, modelCache); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if err := r.reconcilePVC(ctx, modelCache); err != nil {
+		logger.Error(err, "Failed to reconcile shared cache PVC")
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileWarmupJob(ctx, modelCache); err != nil {
+		logger.Error(err, "Failed to reconcile warm-up Job")
+		return ctrl.Result{}, err
+	}
+
+	modelCache.Status.ObservedGeneration = modelCache.Generation
+	if err := r.Status().Update(ctx, modelCache); err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("Successfully reconciled AIMModelCache")
+	return ctrl.Result{RequeueAfter: time.Minute * 15}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AIMModelCacheReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&aimv1alpha1.AIMModelCache{}).
+		Owns(&corev1.PersistentVolumeClaim{}).
+		Owns(&batchv1.Job{}).
+		Complete(r)
+}
+
+// pvcName is the shared, ReadWriteMany PVC name that endpoints reference via CacheRef
+func pvcName(modelCache *aimv1alpha1.AIMModelCache) string {
+	return fmt.Sprintf("%s-shared-cache", modelCache.Name)
+}
+
+// reconcilePVC creates or updates the shared ReadWriteMany PVC that endpoints
+// mount via AIMEndpointSpec.Cache.CacheRef
+func (r *AIMModelCacheReconciler) reconcilePVC(ctx context.Context, modelCache *aimv1alpha1.AIMModelCache) error {
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pvcName(modelCache),
+			Namespace: modelCache.Namespace,
+		},
+	}
+
+	_, err := ctrl.CreateOrUpdate(ctx, r.Client, pvc, func() error {
+		pvc.Labels = map[string]string{
+			"app.kubernetes.io/name":      "aim-model-cache",
+			"app.kubernetes.io/instance":  modelCache.Name,
+			"app.kubernetes.io/component": "shared-cache",
+		}
+
+		pvc.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(modelCache, aimv1alpha1.GroupVersion.WithKind("AIMModelCache")),
+		}
+
+		accessMode := corev1.ReadWriteMany
+		if modelCache.Spec.Storage.AccessMode != "" {
+			accessMode = corev1.PersistentVolumeAccessMode(modelCache.Spec.Storage.AccessMode)
+		}
+
+		pvc.Spec = corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{accessMode},
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: resource.MustParse(modelCache.Spec.Storage.Size),
+				},
+			},
+		}
+
+		if modelCache.Spec.Storage.StorageClass != "" {
+			pvc.Spec.StorageClassName = &modelCache.Spec.Storage.StorageClass
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+// warmupJobName is the one-shot Job that downloads and verifies modelCache's
+// single model. Re-derived from modelCache.Name, so at most one runs at a time.
+func warmupJobName(modelCache *aimv1alpha1.AIMModelCache) string {
+	return fmt.Sprintf("%s-warmup", modelCache.Name)
+}
+
+// warmupJobLabels are applied to the warm-up Job and its pod.
+func warmupJobLabels(modelCache *aimv1alpha1.AIMModelCache) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":      "aim-model-cache",
+		"app.kubernetes.io/instance":  modelCache.Name,
+		"app.kubernetes.io/component": "warmup",
+	}
+}
+
+// warmupPodSpec mounts the shared PVC read-write so the puller image
+// (r.pullerImage(), the same image AIMCache's preload Jobs use) can download
+// Spec.Model into it and patch its progress onto preloadProgressAnnotation.
+func warmupPodSpec(modelCache *aimv1alpha1.AIMModelCache, image string) corev1.PodSpec {
+	mountPath := modelCache.Spec.Storage.MountPath
+	if mountPath == "" {
+		mountPath = "/cache"
+	}
+	return corev1.PodSpec{
+		RestartPolicy: corev1.RestartPolicyOnFailure,
+		Containers: []corev1.Container{
+			{
+				Name:  "puller",
+				Image: image,
+				Env: []corev1.EnvVar{
+					{Name: "AIM_PRELOAD_MODEL_ID", Value: modelCache.Spec.Model.ID},
+					{Name: "AIM_PRELOAD_MODEL_VERSION", Value: modelCache.Spec.Model.Version},
+					{Name: "AIM_PRELOAD_MODEL_REVISION", Value: modelCache.Spec.Model.Revision},
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: "cache", MountPath: mountPath},
+				},
+			},
+		},
+		Volumes: []corev1.Volume{
+			{
+				Name: "cache",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: pvcName(modelCache),
+					},
+				},
+			},
+		},
+	}
+}
+
+// reconcileWarmupJob pre-downloads and verifies the model into the shared PVC
+// before any AIMEndpoint references it, eliminating per-pod cold-start
+// downloads. Status.Phase tracks the Job's own lifecycle instead of being set
+// to "Ready" unconditionally, so Cache.CacheRef consumers can tell a warm-up
+// still in flight from one that's actually done.
+func (r *AIMModelCacheReconciler) reconcileWarmupJob(ctx context.Context, modelCache *aimv1alpha1.AIMModelCache) error {
+	name := warmupJobName(modelCache)
+	job := &batchv1.Job{}
+	err := r.Get(ctx, client.ObjectKey{Name: name, Namespace: modelCache.Namespace}, job)
+	switch {
+	case errors.IsNotFound(err):
+		if modelCache.Status.Phase == "Ready" {
+			// Already warmed up by a Job that's since been GC'd by its TTL.
+			return nil
+		}
+		backoffLimit := int32(3)
+		ttl := int32(3600)
+		job = &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: modelCache.Namespace,
+				Labels:    warmupJobLabels(modelCache),
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(modelCache, aimv1alpha1.GroupVersion.WithKind("AIMModelCache")),
+				},
+			},
+			Spec: batchv1.JobSpec{
+				BackoffLimit:            &backoffLimit,
+				TTLSecondsAfterFinished: &ttl,
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: warmupJobLabels(modelCache)},
+					Spec:       warmupPodSpec(modelCache, r.pullerImage()),
+				},
+			},
+		}
+		modelCache.Status.Phase = "Downloading"
+		return r.Create(ctx, job)
+	case err != nil:
+		return err
+	}
+
+	switch {
+	case job.Status.CompletionTime != nil:
+		progress := 100.0
+		modelCache.Status.Phase = "Ready"
+		modelCache.Status.DownloadProgress = &progress
+		now := metav1.Now()
+		modelCache.Status.LastRefresh = &now
+	case job.Status.Failed > 0 && job.Status.Active == 0:
+		modelCache.Status.Phase = "Failed"
+	default:
+		modelCache.Status.Phase = "Downloading"
+		if raw, ok := job.Annotations[preloadProgressAnnotation]; ok {
+			if progress, err := strconv.ParseFloat(raw, 64); err == nil {
+				modelCache.Status.DownloadProgress = &progress
+			}
+		}
+	}
+	return nil
+}
+
+// handleDeletion handles the deletion of the model cache, blocking while any
+// AIMEndpoint still references it via Cache.CacheRef
+func (r *AIMModelCacheReconciler) handleDeletion(ctx context.Context, modelCache *aimv1alpha1.AIMModelCache) (ctrl.Result, error) {
+	endpoints := &aimv1alpha1.AIMEndpointList{}
+	if err := r.List(ctx, endpoints, client.InNamespace(modelCache.Namespace)); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	endpointCount := int32(0)
+	for _, endpoint := range endpoints.Items {
+		if endpoint.Spec.Cache.CacheRef != nil && endpoint.Spec.Cache.CacheRef.Name == modelCache.Name {
+			endpointCount++
+		}
+	}
+
+	if endpointCount > 0 {
+		modelCache.Status.Phase = "DeletionBlocked"
+		modelCache.Status.Usage = &aimv1alpha1.UsageStatus{EndpointCount: &endpointCount}
+		r.Status().Update(ctx, modelCache)
+		return ctrl.Result{RequeueAfter: time.Minute * 5}, nil
+	}
+
+	modelCache.Finalizers = removeString(modelCache.Finalizers, "aimmodelcache.aim.engine.amd.com/finalizer")
+	if err := r.Update(ctx, modelCache); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
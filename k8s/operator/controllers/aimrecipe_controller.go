@@ -22,14 +22,18 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	aimv1alpha1 "github.com/aim-engine/operator/api/v1alpha1"
+	"github.com/aim-engine/operator/pkg/recipeselect"
 )
 
 // AIMRecipeReconciler reconciles a AIMRecipe object
@@ -80,15 +84,14 @@ func (r *AIMRecipeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	if err := r.validateRecipe(ctx, aimRecipe); err != nil {
 		logger.Error(err, "Recipe validation failed")
 		aimRecipe.Status.Phase = "Invalid"
-		aimRecipe.Status.Conditions = []metav1.Condition{
-			{
-				Type:               "Valid",
-				Status:             metav1.ConditionFalse,
-				Reason:             "ValidationFailed",
-				Message:            fmt.Sprintf("Recipe validation failed: %v", err),
-				LastTransitionTime: metav1.Now(),
-			},
-		}
+		aimRecipe.Status.ObservedGeneration = aimRecipe.Generation
+		meta.SetStatusCondition(&aimRecipe.Status.Conditions, metav1.Condition{
+			Type:               aimv1alpha1.ConditionValid,
+			Status:             metav1.ConditionFalse,
+			Reason:             aimv1alpha1.ReasonValidationFailed,
+			Message:            fmt.Sprintf("Recipe validation failed: %v", err),
+			ObservedGeneration: aimRecipe.Generation,
+		})
 		r.Status().Update(ctx, aimRecipe)
 		return ctrl.Result{RequeueAfter: time.Minute * 5}, err
 	}
@@ -99,18 +102,25 @@ func (r *AIMRecipeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{}, err
 	}
 
+	aimRecipe.Status.Compatibility = recipeselect.CompatibleHardware(aimRecipe.Spec.Hardware)
+
 	// Update status
 	aimRecipe.Status.Phase = "Ready"
-	aimRecipe.Status.Conditions = []metav1.Condition{
-		{
-			Type:               "Valid",
-			Status:             metav1.ConditionTrue,
-			Reason:             "ValidationSucceeded",
-			Message:            "Recipe is valid and ready for use",
-			LastTransitionTime: metav1.Now(),
-		},
-	}
 	aimRecipe.Status.ObservedGeneration = aimRecipe.Generation
+	meta.SetStatusCondition(&aimRecipe.Status.Conditions, metav1.Condition{
+		Type:               aimv1alpha1.ConditionValid,
+		Status:             metav1.ConditionTrue,
+		Reason:             aimv1alpha1.ReasonValidationSucceeded,
+		Message:            "Recipe is valid and ready for use",
+		ObservedGeneration: aimRecipe.Generation,
+	})
+	meta.SetStatusCondition(&aimRecipe.Status.Conditions, metav1.Condition{
+		Type:               aimv1alpha1.ConditionReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             aimv1alpha1.ReasonReconcileSucceeded,
+		Message:            "Recipe reconciled successfully",
+		ObservedGeneration: aimRecipe.Generation,
+	})
 
 	if err := r.Status().Update(ctx, aimRecipe); err != nil {
 		logger.Error(err, "Failed to update status")
@@ -121,94 +131,77 @@ func (r *AIMRecipeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	return ctrl.Result{RequeueAfter: time.Minute * 10}, nil
 }
 
+// selectedRecipeNameIndex is the field index key for AIMEndpoint.status.selectedRecipe.name,
+// keyed as "<namespace>/<recipe name>" so two AIMRecipes with the same name
+// in different namespaces aren't conflated.
+const selectedRecipeNameIndex = "status.selectedRecipe.name"
+
+// selectedRecipeIndexKey builds the selectedRecipeNameIndex value for a
+// recipe name in namespace.
+func selectedRecipeIndexKey(namespace, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *AIMRecipeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &aimv1alpha1.AIMEndpoint{}, selectedRecipeNameIndex, func(obj client.Object) []string {
+		endpoint := obj.(*aimv1alpha1.AIMEndpoint)
+		if endpoint.Status.SelectedRecipe == nil || endpoint.Status.SelectedRecipe.Name == "" {
+			return nil
+		}
+		return []string{selectedRecipeIndexKey(endpoint.Namespace, endpoint.Status.SelectedRecipe.Name)}
+	}); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&aimv1alpha1.AIMRecipe{}).
+		Watches(
+			&aimv1alpha1.AIMEndpoint{},
+			handler.EnqueueRequestsFromMapFunc(r.mapEndpointToRecipe),
+		).
 		Complete(r)
 }
 
-// validateRecipe validates the recipe configuration
-func (r *AIMRecipeReconciler) validateRecipe(ctx context.Context, recipe *aimv1alpha1.AIMRecipe) error {
-	// Validate hardware platform
-	validHardware := []string{"MI300X", "MI325X", "MI355X", "MI250", "MI210"}
-	hardwareValid := false
-	for _, hw := range validHardware {
-		if recipe.Spec.Hardware == hw {
-			hardwareValid = true
-			break
-		}
-	}
-	if !hardwareValid {
-		return fmt.Errorf("invalid hardware platform: %s", recipe.Spec.Hardware)
+// mapEndpointToRecipe enqueues a reconcile for the AIMRecipe an AIMEndpoint selected,
+// so usage statistics and deletion-blocked state react within seconds of an endpoint
+// attaching or detaching instead of waiting for the next 10-minute recipe reconcile.
+func (r *AIMRecipeReconciler) mapEndpointToRecipe(ctx context.Context, obj client.Object) []reconcile.Request {
+	endpoint, ok := obj.(*aimv1alpha1.AIMEndpoint)
+	if !ok || endpoint.Status.SelectedRecipe == nil || endpoint.Status.SelectedRecipe.Name == "" {
+		return nil
 	}
 
-	// Validate precision
-	validPrecisions := []string{"bfloat16", "float16", "float8", "int8", "int4"}
-	precisionValid := false
-	for _, p := range validPrecisions {
-		if recipe.Spec.Precision == p {
-			precisionValid = true
-			break
-		}
-	}
-	if !precisionValid {
-		return fmt.Errorf("invalid precision: %s", recipe.Spec.Precision)
-	}
-
-	// Validate backend
-	validBackends := []string{"vllm", "sglang"}
-	backendValid := false
-	for _, b := range validBackends {
-		if recipe.Spec.Backend == b {
-			backendValid = true
-			break
-		}
-	}
-	if !backendValid {
-		return fmt.Errorf("invalid backend: %s", recipe.Spec.Backend)
-	}
-
-	// Validate configurations
-	if len(recipe.Spec.Configurations) == 0 {
-		return fmt.Errorf("at least one GPU configuration is required")
-	}
-
-	enabledConfigs := 0
-	for i, config := range recipe.Spec.Configurations {
-		if config.GPUCount < 1 || config.GPUCount > 8 {
-			return fmt.Errorf("configuration %d: GPU count must be between 1 and 8", i)
-		}
-		if config.Enabled {
-			enabledConfigs++
-		}
-	}
-
-	if enabledConfigs == 0 {
-		return fmt.Errorf("at least one GPU configuration must be enabled")
+	return []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Name: endpoint.Status.SelectedRecipe.Name, Namespace: endpoint.Namespace}},
 	}
+}
 
-	return nil
+// validateRecipe performs a lightweight revalidation pass for cases where the
+// admission webhook was bypassed (e.g. during upgrades). Primary enforcement
+// happens at apply time via AIMRecipeCustomValidator, which shares this same
+// ValidateRecipeSpec logic so there is one source of truth for valid values.
+func (r *AIMRecipeReconciler) validateRecipe(ctx context.Context, recipe *aimv1alpha1.AIMRecipe) error {
+	return aimv1alpha1.ValidateRecipeSpec(recipe)
 }
 
-// updateUsageStatistics updates the usage statistics for the recipe
+// updateUsageStatistics updates the usage statistics for the recipe. It lists
+// only endpoints indexed against this recipe via selectedRecipeNameIndex, so
+// cost is O(k) in the number of endpoints actually using the recipe rather
+// than O(n) over every endpoint in the cluster.
 func (r *AIMRecipeReconciler) updateUsageStatistics(ctx context.Context, recipe *aimv1alpha1.AIMRecipe) error {
-	// Find all endpoints using this recipe
 	endpoints := &aimv1alpha1.AIMEndpointList{}
-	err := r.List(ctx, endpoints)
+	err := r.List(ctx, endpoints, client.InNamespace(recipe.Namespace), client.MatchingFields{selectedRecipeNameIndex: selectedRecipeIndexKey(recipe.Namespace, recipe.Name)})
 	if err != nil {
 		return err
 	}
 
-	endpointCount := int32(0)
+	endpointCount := int32(len(endpoints.Items))
 	var lastUsed *metav1.Time
 
 	for _, endpoint := range endpoints.Items {
-		if endpoint.Status.SelectedRecipe != nil && endpoint.Status.SelectedRecipe.Name == recipe.Name {
-			endpointCount++
-			if lastUsed == nil || endpoint.CreationTimestamp.After(lastUsed.Time) {
-				lastUsed = &endpoint.CreationTimestamp
-			}
+		if lastUsed == nil || endpoint.CreationTimestamp.After(lastUsed.Time) {
+			lastUsed = &endpoint.CreationTimestamp
 		}
 	}
 
@@ -225,30 +218,27 @@ func (r *AIMRecipeReconciler) updateUsageStatistics(ctx context.Context, recipe
 func (r *AIMRecipeReconciler) handleDeletion(ctx context.Context, recipe *aimv1alpha1.AIMRecipe) (ctrl.Result, error) {
 	// Check if any endpoints are still using this recipe
 	endpoints := &aimv1alpha1.AIMEndpointList{}
-	err := r.List(ctx, endpoints)
+	err := r.List(ctx, endpoints, client.InNamespace(recipe.Namespace), client.MatchingFields{selectedRecipeNameIndex: selectedRecipeIndexKey(recipe.Namespace, recipe.Name)})
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
 	usingEndpoints := []string{}
 	for _, endpoint := range endpoints.Items {
-		if endpoint.Status.SelectedRecipe != nil && endpoint.Status.SelectedRecipe.Name == recipe.Name {
-			usingEndpoints = append(usingEndpoints, fmt.Sprintf("%s/%s", endpoint.Namespace, endpoint.Name))
-		}
+		usingEndpoints = append(usingEndpoints, fmt.Sprintf("%s/%s", endpoint.Namespace, endpoint.Name))
 	}
 
 	if len(usingEndpoints) > 0 {
 		// Recipe is still in use, prevent deletion
 		recipe.Status.Phase = "DeletionBlocked"
-		recipe.Status.Conditions = []metav1.Condition{
-			{
-				Type:               "DeletionBlocked",
-				Status:             metav1.ConditionTrue,
-				Reason:             "EndpointsStillUsing",
-				Message:            fmt.Sprintf("Cannot delete recipe: still in use by endpoints: %v", usingEndpoints),
-				LastTransitionTime: metav1.Now(),
-			},
-		}
+		recipe.Status.ObservedGeneration = recipe.Generation
+		meta.SetStatusCondition(&recipe.Status.Conditions, metav1.Condition{
+			Type:               aimv1alpha1.ConditionDeletionBlocked,
+			Status:             metav1.ConditionTrue,
+			Reason:             aimv1alpha1.ReasonStillReferenced,
+			Message:            fmt.Sprintf("Cannot delete recipe: still in use by endpoints: %v", usingEndpoints),
+			ObservedGeneration: recipe.Generation,
+		})
 		r.Status().Update(ctx, recipe)
 		return ctrl.Result{RequeueAfter: time.Minute * 5}, nil
 	}
@@ -260,4 +250,4 @@ func (r *AIMRecipeReconciler) handleDeletion(ctx context.Context, recipe *aimv1a
 	}
 
 	return ctrl.Result{}, nil
-} 
\ No newline at end of file
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	aimv1alpha1 "github.com/aim-engine/operator/api/v1alpha1"
+)
+
+// AIMRecipeDisruptionReconciler watches AIMRecipe specs for drift against the
+// recipe an endpoint last selected, and marks endpoints for rollout according
+// to the recipe's opt-in disruption policy, in the style of Karpenter's
+// disruption controller and disruption budgets.
+type AIMRecipeDisruptionReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+//+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimrecipes,verbs=get;list;watch
+//+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimrecipes/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aim.engine.amd.com,resources=aimendpoints,verbs=get;list;watch;update
+
+// Reconcile computes the recipe's spec hash, detects drift against each
+// referencing endpoint, and marks drifted endpoints for rollout within the
+// recipe's disruption budget.
+func (r *AIMRecipeDisruptionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	recipe := &aimv1alpha1.AIMRecipe{}
+	if err := r.Get(ctx, req.NamespacedName, recipe); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	specHash := r.computeSpecHash(recipe)
+	recipe.Status.SpecHash = specHash
+
+	if recipe.Spec.Disruption == nil || recipe.Spec.Disruption.Policy == "Never" {
+		return ctrl.Result{}, r.Status().Update(ctx, recipe)
+	}
+
+	endpoints := &aimv1alpha1.AIMEndpointList{}
+	if err := r.List(ctx, endpoints); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if recipe.Status.Drift == nil {
+		recipe.Status.Drift = map[string]string{}
+	}
+
+	maxDrifted := int32(1)
+	if recipe.Spec.Disruption.MaxDisruptedEndpoints != nil {
+		maxDrifted = *recipe.Spec.Disruption.MaxDisruptedEndpoints
+	}
+
+	drifted := int32(0)
+	for i := range endpoints.Items {
+		endpoint := &endpoints.Items[i]
+		if endpoint.Status.SelectedRecipe == nil || endpoint.Status.SelectedRecipe.Name != recipe.Name {
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%s", endpoint.Namespace, endpoint.Name)
+		lastHash, seen := recipe.Status.Drift[key]
+		if !seen {
+			// First time we've observed this endpoint against this recipe:
+			// record its current hash as a baseline instead of treating it
+			// as drifted, since there's nothing to have drifted from yet.
+			recipe.Status.Drift[key] = specHash
+			continue
+		}
+
+		if lastHash == specHash {
+			continue
+		}
+		if !r.eligibleForDisruption(recipe, endpoint) {
+			continue
+		}
+		if drifted >= maxDrifted {
+			break
+		}
+		drifted++
+		// Only advance the recorded hash once the endpoint is actually
+		// marked drifted, so one that's ineligible or loses the budget race
+		// keeps its stale lastHash and stays eligible to be caught next
+		// reconcile instead of silently falling off the radar.
+		recipe.Status.Drift[key] = specHash
+
+		meta.SetStatusCondition(&endpoint.Status.Conditions, metav1.Condition{
+			Type:               aimv1alpha1.ConditionDrifted,
+			Status:             metav1.ConditionTrue,
+			Reason:             aimv1alpha1.ReasonSpecHashChanged,
+			Message:            fmt.Sprintf("Selected recipe %s drifted to spec hash %s", recipe.Name, specHash),
+			ObservedGeneration: endpoint.Generation,
+		})
+		if err := r.Status().Update(ctx, endpoint); err != nil {
+			logger.Error(err, "Failed to mark endpoint as drifted", "endpoint", key)
+		}
+	}
+
+	driftedCondition := metav1.ConditionFalse
+	driftedMessage := fmt.Sprintf("Spec hash %s", specHash)
+	if drifted > 0 {
+		driftedCondition = metav1.ConditionTrue
+		driftedMessage = fmt.Sprintf("Spec hash %s; %d endpoint(s) marked drifted this pass", specHash, drifted)
+	}
+	meta.SetStatusCondition(&recipe.Status.Conditions, metav1.Condition{
+		Type:               aimv1alpha1.ConditionDrifted,
+		Status:             driftedCondition,
+		Reason:             aimv1alpha1.ReasonSpecHashChanged,
+		Message:            driftedMessage,
+		ObservedGeneration: recipe.Generation,
+	})
+
+	if err := r.Status().Update(ctx, recipe); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: time.Minute * 10}, nil
+}
+
+// eligibleForDisruption applies the recipe's disruption policy (WhenEmpty,
+// WhenIdle) to decide whether an endpoint may be marked drifted right now.
+func (r *AIMRecipeDisruptionReconciler) eligibleForDisruption(recipe *aimv1alpha1.AIMRecipe, endpoint *aimv1alpha1.AIMEndpoint) bool {
+	switch recipe.Spec.Disruption.Policy {
+	case "WhenEmpty":
+		return endpoint.Status.Replicas == nil || endpoint.Status.Replicas.Current == nil || *endpoint.Status.Replicas.Current == 0
+	case "WhenIdle":
+		return endpoint.Status.Metrics == nil || endpoint.Status.Metrics.Throughput == nil || *endpoint.Status.Metrics.Throughput == 0
+	default:
+		return true
+	}
+}
+
+// computeSpecHash hashes the runtime-affecting fields of the recipe spec:
+// Hardware, Precision, Backend, and the set of enabled Configurations.
+func (r *AIMRecipeDisruptionReconciler) computeSpecHash(recipe *aimv1alpha1.AIMRecipe) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "hardware=%s;precision=%s;backend=%s;", recipe.Spec.Hardware, recipe.Spec.Precision, recipe.Spec.Backend)
+
+	enabled := []int32{}
+	for _, config := range recipe.Spec.Configurations {
+		if config.Enabled {
+			enabled = append(enabled, config.GPUCount)
+		}
+	}
+	sort.Slice(enabled, func(i, j int) bool { return enabled[i] < enabled[j] })
+	for _, gpuCount := range enabled {
+		fmt.Fprintf(h, "gpu=%d;", gpuCount)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AIMRecipeDisruptionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&aimv1alpha1.AIMRecipe{}).
+		Complete(r)
+}
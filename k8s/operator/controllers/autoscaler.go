@@ -0,0 +1,237 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	aimv1alpha1 "github.com/aim-engine/operator/api/v1alpha1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+)
+
+// scaledObjectGVK is the keda.sh/v1alpha1 ScaledObject GroupVersionKind. KEDA is
+// not part of this operator's scheme, so ScaledObjects are read and written as
+// unstructured content rather than through a typed client.
+var scaledObjectGVK = schema.GroupVersionKind{Group: "keda.sh", Version: "v1alpha1", Kind: "ScaledObject"}
+
+// AutoscalerReconciler provisions and removes the autoscaling resource for an
+// AIMEndpoint's Deployment. Each ScalingSpec.AutoscalerClass value maps to one
+// implementation; AIMEndpointReconciler dispatches to whichever is selected
+// and asks every other implementation to Cleanup, so switching classes (e.g.
+// hpa -> keda) doesn't leave the old autoscaler orphaned.
+type AutoscalerReconciler interface {
+	// Reconcile creates or updates the autoscaler for endpoint.
+	Reconcile(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error
+
+	// Cleanup removes any autoscaler this implementation may have previously
+	// created for endpoint. It is a no-op if none exists.
+	Cleanup(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error
+}
+
+// autoscalers returns every known AutoscalerReconciler keyed by autoscalerClass.
+func (r *AIMEndpointReconciler) autoscalers() map[string]AutoscalerReconciler {
+	return map[string]AutoscalerReconciler{
+		aimv1alpha1.AutoscalerClassHPA:  &hpaAutoscaler{r: r},
+		aimv1alpha1.AutoscalerClassKEDA: &kedaAutoscaler{r: r},
+	}
+}
+
+// reconcileAutoscaler dispatches to the AutoscalerReconciler selected by
+// Scaling.AutoscalerClass (defaulting to hpa for backward compatibility with
+// endpoints created before this field existed), and cleans up every other
+// known class's resource so changing class doesn't orphan the old one.
+// "external" and "none" select no autoscaler at all, just cleanup.
+func (r *AIMEndpointReconciler) reconcileAutoscaler(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
+	class := endpoint.Spec.Scaling.AutoscalerClass
+	if class == "" {
+		class = aimv1alpha1.AutoscalerClassHPA
+	}
+
+	for otherClass, autoscaler := range r.autoscalers() {
+		if otherClass == class {
+			continue
+		}
+		if err := autoscaler.Cleanup(ctx, endpoint); err != nil {
+			return fmt.Errorf("cleaning up %s autoscaler: %w", otherClass, err)
+		}
+	}
+
+	autoscaler, ok := r.autoscalers()[class]
+	if !ok {
+		// external or none: no autoscaler to reconcile, only cleanup above applies
+		return nil
+	}
+
+	return autoscaler.Reconcile(ctx, endpoint)
+}
+
+// hpaAutoscaler is the AutoscalerReconciler for ScalingSpec.AutoscalerClass "hpa".
+type hpaAutoscaler struct {
+	r *AIMEndpointReconciler
+}
+
+func (a *hpaAutoscaler) Reconcile(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
+	if endpoint.Spec.Topology.IsMultiNode() {
+		// A Deployment-scale-subresource HPA has no target in multiNode mode
+		// (there's no Deployment); multi-node endpoints don't get horizontal
+		// scaling yet, only the fixed leader-worker group count in
+		// Spec.Scaling.MinReplicas. "hpa" is still the selected class here
+		// (it's reconcileAutoscaler's default), so clean up any HPA left over
+		// from before the endpoint switched to multiNode.
+		return a.Cleanup(ctx, endpoint)
+	}
+	if endpoint.Spec.Scaling.MaxReplicas == nil || *endpoint.Spec.Scaling.MaxReplicas <= 1 {
+		return nil
+	}
+	return a.r.reconcileHPA(ctx, endpoint)
+}
+
+func (a *hpaAutoscaler) Cleanup(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: endpoint.Name, Namespace: endpoint.Namespace},
+	}
+	if err := a.r.Delete(ctx, hpa); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// kedaAutoscaler is the AutoscalerReconciler for ScalingSpec.AutoscalerClass "keda".
+// It translates Scaling.Triggers into KEDA triggers on Prometheus-scraped
+// inference metrics (vllm:num_requests_running, amd_smi GPU utilization,
+// request queue depth) instead of CPU/memory, which barely move on GPU-bound
+// inference workloads.
+type kedaAutoscaler struct {
+	r *AIMEndpointReconciler
+}
+
+func (a *kedaAutoscaler) Reconcile(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
+	if len(endpoint.Spec.Scaling.Triggers) == 0 {
+		return nil
+	}
+
+	scaledObject := &unstructured.Unstructured{}
+	scaledObject.SetGroupVersionKind(scaledObjectGVK)
+	scaledObject.SetName(endpoint.Name)
+	scaledObject.SetNamespace(endpoint.Namespace)
+
+	_, err := ctrl.CreateOrUpdate(ctx, a.r.Client, scaledObject, func() error {
+		scaledObject.SetLabels(map[string]string{
+			"app.kubernetes.io/name":      "aim-endpoint",
+			"app.kubernetes.io/instance":  endpoint.Name,
+			"app.kubernetes.io/component": "autoscaler",
+		})
+		scaledObject.SetOwnerReferences([]metav1.OwnerReference{
+			*metav1.NewControllerRef(endpoint, aimv1alpha1.GroupVersion.WithKind("AIMEndpoint")),
+		})
+
+		minReplicas := int32(0)
+		if endpoint.Spec.Scaling.MinReplicas != nil {
+			minReplicas = *endpoint.Spec.Scaling.MinReplicas
+		}
+		maxReplicas := int32(1)
+		if endpoint.Spec.Scaling.MaxReplicas != nil {
+			maxReplicas = *endpoint.Spec.Scaling.MaxReplicas
+		}
+		cooldownPeriod := int32(300)
+		if endpoint.Spec.Scaling.CooldownPeriod != nil {
+			cooldownPeriod = *endpoint.Spec.Scaling.CooldownPeriod
+		}
+		idleReplicaCount := minReplicas
+		if endpoint.Spec.Scaling.ScaleToZero != nil && *endpoint.Spec.Scaling.ScaleToZero {
+			idleReplicaCount = 0
+		}
+
+		spec := map[string]interface{}{
+			"scaleTargetRef": map[string]interface{}{
+				"name": endpoint.Name,
+			},
+			"minReplicaCount": int64(minReplicas),
+			"maxReplicaCount": int64(maxReplicas),
+			"cooldownPeriod":  int64(cooldownPeriod),
+			"triggers":        kedaTriggers(endpoint.Spec.Scaling.Triggers),
+		}
+		if idleReplicaCount == 0 {
+			spec["idleReplicaCount"] = int64(0)
+		}
+
+		return unstructured.SetNestedMap(scaledObject.Object, spec, "spec")
+	})
+
+	return err
+}
+
+func (a *kedaAutoscaler) Cleanup(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
+	scaledObject := &unstructured.Unstructured{}
+	scaledObject.SetGroupVersionKind(scaledObjectGVK)
+	err := a.r.Get(ctx, types.NamespacedName{Name: endpoint.Name, Namespace: endpoint.Namespace}, scaledObject)
+	if err != nil {
+		if errors.IsNotFound(err) || isKindNotRegistered(err) {
+			return nil
+		}
+		return err
+	}
+	if err := a.r.Delete(ctx, scaledObject); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// kedaTriggers converts ScalingTrigger entries into KEDA prometheus trigger specs.
+func kedaTriggers(triggers []aimv1alpha1.ScalingTrigger) []interface{} {
+	result := make([]interface{}, 0, len(triggers))
+	for _, trigger := range triggers {
+		metadata := map[string]interface{}{
+			"metricName": trigger.Metric,
+			"query":      trigger.Metric,
+			"threshold":  trigger.Target,
+		}
+		if trigger.ServerAddress != "" {
+			metadata["serverAddress"] = trigger.ServerAddress
+		}
+		if trigger.ActivationTarget != "" {
+			metadata["activationThreshold"] = trigger.ActivationTarget
+		}
+
+		triggerType := trigger.Source
+		if triggerType == "" {
+			triggerType = "prometheus"
+		}
+
+		result = append(result, map[string]interface{}{
+			"type":     triggerType,
+			"metadata": metadata,
+		})
+	}
+	return result
+}
+
+// isKindNotRegistered reports whether err indicates the KEDA ScaledObject CRD
+// (or its REST mapping) isn't installed in this cluster, which is expected on
+// clusters without KEDA and shouldn't fail reconciliation.
+func isKindNotRegistered(err error) bool {
+	return meta.IsNoMatchError(err)
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Metrics describing AIMCache's multi-tier storage, exposed on the
+// manager's existing /metrics endpoint.
+var (
+	aimCacheTierUsageBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "aim_cache_tier_usage_bytes",
+			Help: "Bytes currently used on each AIMCache storage tier.",
+		},
+		[]string{"namespace", "cache", "tier"},
+	)
+
+	aimCacheMigrationsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "aim_cache_migrations_total",
+			Help: "Total number of model directories moved between AIMCache storage tiers.",
+		},
+		[]string{"namespace", "cache", "from_tier", "to_tier"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(aimCacheTierUsageBytes, aimCacheMigrationsTotal)
+}
@@ -0,0 +1,478 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	aimv1alpha1 "github.com/aim-engine/operator/api/v1alpha1"
+	"github.com/aim-engine/operator/pkg/statuscheck"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// leaderWorkerSetGVK is the leaderworkerset.x-k8s.io/v1 LeaderWorkerSet
+// GroupVersionKind. LWS is not part of this operator's scheme, so it's read
+// and written as unstructured content, the same as KEDA's ScaledObject.
+var leaderWorkerSetGVK = schema.GroupVersionKind{Group: "leaderworkerset.x-k8s.io", Version: "v1", Kind: "LeaderWorkerSet"}
+
+// MultiNodeReconciler provisions and removes the resources backing a
+// multi-node AIMEndpoint replica. Each ServingTopologySpec.Backend value maps
+// to one implementation; reconcileMultiNode dispatches to whichever is
+// selected and asks the other to Cleanup, mirroring AutoscalerReconciler.
+type MultiNodeReconciler interface {
+	// Reconcile creates or updates the multi-node workload for endpoint.
+	Reconcile(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error
+
+	// Cleanup removes any resources this implementation may have previously
+	// created for endpoint. It is a no-op if none exist.
+	Cleanup(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error
+}
+
+func (r *AIMEndpointReconciler) multiNodeBackends() map[string]MultiNodeReconciler {
+	return map[string]MultiNodeReconciler{
+		aimv1alpha1.MultiNodeBackendLeaderWorkerSet: &leaderWorkerSetBackend{r: r},
+		aimv1alpha1.MultiNodeBackendStatefulSet:     &statefulSetBackend{r: r},
+	}
+}
+
+// reconcileMultiNode dispatches to the MultiNodeReconciler selected by
+// Topology.Backend when Topology.Mode is multiNode, cleaning up the other
+// backend's resources so switching backends doesn't orphan them. When
+// singleNode (or Topology unset) it cleans up every multi-node backend
+// instead, so rolling a spec back to singleNode removes leftover leader/
+// worker resources.
+func (r *AIMEndpointReconciler) reconcileMultiNode(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
+	if !endpoint.Spec.Topology.IsMultiNode() {
+		for name, backend := range r.multiNodeBackends() {
+			if err := backend.Cleanup(ctx, endpoint); err != nil {
+				return fmt.Errorf("cleaning up %s multi-node backend: %w", name, err)
+			}
+		}
+		return nil
+	}
+
+	selected := endpoint.Spec.Topology.ResolvedBackend()
+	for name, backend := range r.multiNodeBackends() {
+		if name == selected {
+			continue
+		}
+		if err := backend.Cleanup(ctx, endpoint); err != nil {
+			return fmt.Errorf("cleaning up %s multi-node backend: %w", name, err)
+		}
+	}
+
+	backend, ok := r.multiNodeBackends()[selected]
+	if !ok {
+		return fmt.Errorf("unknown multi-node backend %q", selected)
+	}
+	return backend.Reconcile(ctx, endpoint)
+}
+
+// cleanupDeployment removes the single-node Deployment left over from a spec
+// that has since switched to multiNode, so changing Topology.Mode doesn't
+// leave an orphaned Deployment alongside the new LWS/StatefulSet.
+func (r *AIMEndpointReconciler) cleanupDeployment(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: endpoint.Name, Namespace: endpoint.Namespace}}
+	if err := r.Delete(ctx, deployment); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// multiNodeRole distinguishes the leader pod (rank 0, which starts the
+// distributed runtime and serves HTTP traffic) from worker pods, which only
+// join the runtime the leader started.
+type multiNodeRole string
+
+const (
+	multiNodeRoleLeader multiNodeRole = "leader"
+	multiNodeRoleWorker multiNodeRole = "worker"
+)
+
+func multiNodeLabels(endpoint *aimv1alpha1.AIMEndpoint) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":      "aim-endpoint",
+		"app.kubernetes.io/instance":  endpoint.Name,
+		"app.kubernetes.io/component": "server",
+	}
+}
+
+func multiNodeHeadlessServiceName(endpoint *aimv1alpha1.AIMEndpoint) string {
+	return fmt.Sprintf("%s-nodes", endpoint.Name)
+}
+
+// multiNodeCommand returns the per-backend launch command for role, used by
+// the LeaderWorkerSet backend where the leader and worker pods each get
+// their own template so the right half of the command can be baked in
+// directly. vLLM coordinates multi-node tensor parallelism through Ray: the
+// leader starts the Ray head and the API server, workers only join the Ray
+// cluster the leader started. SGLang launches every rank directly,
+// distinguished by --node-rank, which $(NODE_RANK) substitutes from the
+// container's own NODE_RANK env var (set by multiNodeRoleEnv).
+func multiNodeCommand(endpoint *aimv1alpha1.AIMEndpoint, role multiNodeRole) []string {
+	backend := aimv1alpha1.DefaultBackend
+	if endpoint.Status.SelectedRecipe != nil && endpoint.Status.SelectedRecipe.Backend != "" {
+		backend = endpoint.Status.SelectedRecipe.Backend
+	}
+
+	switch backend {
+	case "sglang":
+		if role == multiNodeRoleLeader {
+			return []string{"python3", "-m", "sglang.launch_server", "--node-rank", "0"}
+		}
+		return []string{"python3", "-m", "sglang.launch_server", "--node-rank", "$(NODE_RANK)"}
+	default: // vllm
+		if role == multiNodeRoleLeader {
+			return []string{"/bin/sh", "-c", "ray start --head --port=6379 && vllm serve"}
+		}
+		return []string{"/bin/sh", "-c", "ray start --address=$(MASTER_ADDR):6379 --block"}
+	}
+}
+
+// leaderWorkerSetWorkerIndexLabel is the label LeaderWorkerSet's own
+// admission webhook sets on every pod in a worker group (0 on the leader,
+// 1..size-1 on workers), read back via the downward API the same way this
+// file already reads spec.nodeName for NODE_NAME.
+const leaderWorkerSetWorkerIndexLabel = "leaderworkerset.sigs.k8s.io/worker-index"
+
+// multiNodeRoleEnv sets the env vars a pod template built for a known role
+// (LeaderWorkerSet's leaderTemplate/workerTemplate) needs: world size, an
+// explicit role marker the entrypoint/image can read instead of inferring
+// it, and NODE_RANK, which the sglang worker command substitutes via
+// $(NODE_RANK).
+func multiNodeRoleEnv(role multiNodeRole, workerReplicas int32) []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		{Name: "WORLD_SIZE", Value: strconv.Itoa(int(workerReplicas))},
+		{Name: "NODE_ROLE", Value: string(role)},
+	}
+	if role == multiNodeRoleLeader {
+		env = append(env, corev1.EnvVar{Name: "NODE_RANK", Value: "0"})
+	} else {
+		env = append(env, corev1.EnvVar{Name: "NODE_RANK", ValueFrom: &corev1.EnvVarSource{
+			FieldRef: &corev1.ObjectFieldSelector{FieldPath: fmt.Sprintf("metadata.labels['%s']", leaderWorkerSetWorkerIndexLabel)},
+		}})
+	}
+	return env
+}
+
+// statefulSetCommand returns the single command every StatefulSet replica
+// runs: unlike LeaderWorkerSet, a StatefulSet has one shared pod template,
+// so the leader/worker split can't be baked in per-pod by the controller.
+// Instead the command is a small wrapper that reads its own ordinal off the
+// tail of $HOSTNAME (a StatefulSet pod naming guarantee: "<name>-<ordinal>")
+// and runs the leader command on ordinal 0, the worker command everywhere else.
+func statefulSetCommand(endpoint *aimv1alpha1.AIMEndpoint) []string {
+	backend := aimv1alpha1.DefaultBackend
+	if endpoint.Status.SelectedRecipe != nil && endpoint.Status.SelectedRecipe.Backend != "" {
+		backend = endpoint.Status.SelectedRecipe.Backend
+	}
+
+	var leaderCmd, workerCmd string
+	switch backend {
+	case "sglang":
+		leaderCmd = "python3 -m sglang.launch_server --node-rank 0"
+		workerCmd = "python3 -m sglang.launch_server --node-rank $NODE_RANK"
+	default: // vllm
+		leaderCmd = "ray start --head --port=6379 && vllm serve"
+		workerCmd = "ray start --address=$MASTER_ADDR:6379 --block"
+	}
+
+	script := fmt.Sprintf(
+		`export NODE_RANK=${HOSTNAME##*-}; if [ "$NODE_RANK" = "0" ]; then %s; else %s; fi`,
+		leaderCmd, workerCmd,
+	)
+	return []string{"/bin/sh", "-c", script}
+}
+
+// multiNodeServerContainer builds the aim-server container shared by both
+// multi-node backends, varying only the command and role-specific env.
+func (r *AIMEndpointReconciler) multiNodeServerContainer(endpoint *aimv1alpha1.AIMEndpoint, command []string, roleEnv []corev1.EnvVar) corev1.Container {
+	return corev1.Container{
+		Name:      "aim-server",
+		Image:     r.getImage(endpoint),
+		Command:   command,
+		Resources: r.getResourceRequirements(endpoint),
+		Env:       append(r.getEnvironmentVariables(endpoint), roleEnv...),
+		Ports: []corev1.ContainerPort{
+			{Name: "http", ContainerPort: 8000, Protocol: corev1.ProtocolTCP},
+			{Name: "distributed", ContainerPort: 6379, Protocol: corev1.ProtocolTCP},
+		},
+	}
+}
+
+// leaderWorkerSetBackend is the MultiNodeReconciler for
+// ServingTopologySpec.Backend "leaderworkerset". It gives the leader and
+// worker pods distinct templates, so each runs the right half of the
+// backend's distributed launch command without needing to infer its role
+// from its ordinal at runtime.
+type leaderWorkerSetBackend struct {
+	r *AIMEndpointReconciler
+}
+
+func (b *leaderWorkerSetBackend) Reconcile(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
+	lws := &unstructured.Unstructured{}
+	lws.SetGroupVersionKind(leaderWorkerSetGVK)
+	lws.SetName(endpoint.Name)
+	lws.SetNamespace(endpoint.Namespace)
+
+	_, err := ctrl.CreateOrUpdate(ctx, b.r.Client, lws, func() error {
+		lws.SetLabels(multiNodeLabels(endpoint))
+		lws.SetOwnerReferences([]metav1.OwnerReference{
+			*metav1.NewControllerRef(endpoint, aimv1alpha1.GroupVersion.WithKind("AIMEndpoint")),
+		})
+
+		groupReplicas := int32(1)
+		if endpoint.Spec.Scaling.MinReplicas != nil {
+			groupReplicas = *endpoint.Spec.Scaling.MinReplicas
+		}
+		workerReplicas := endpoint.Spec.Topology.ResolvedWorkerReplicas()
+
+		leaderTemplate, err := b.podTemplateUnstructured(endpoint, multiNodeRoleLeader, workerReplicas)
+		if err != nil {
+			return err
+		}
+		workerTemplate, err := b.podTemplateUnstructured(endpoint, multiNodeRoleWorker, workerReplicas)
+		if err != nil {
+			return err
+		}
+
+		spec := map[string]interface{}{
+			"replicas": int64(groupReplicas),
+			"leaderWorkerTemplate": map[string]interface{}{
+				"size":           int64(workerReplicas),
+				"leaderTemplate": leaderTemplate,
+				"workerTemplate": workerTemplate,
+			},
+		}
+
+		return unstructured.SetNestedMap(lws.Object, spec, "spec")
+	})
+
+	return err
+}
+
+// podTemplateUnstructured builds the leader or worker pod template and
+// converts it to unstructured content for embedding in the LeaderWorkerSet,
+// whose leaderTemplate/workerTemplate fields are core/v1.PodTemplateSpec-
+// shaped but untyped in this operator's scheme.
+func (b *leaderWorkerSetBackend) podTemplateUnstructured(endpoint *aimv1alpha1.AIMEndpoint, role multiNodeRole, workerReplicas int32) (map[string]interface{}, error) {
+	template := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: multiNodeLabels(endpoint)},
+		Spec: corev1.PodSpec{
+			Affinity:   b.r.getTopologyAffinity(endpoint),
+			Containers: []corev1.Container{b.r.multiNodeServerContainer(endpoint, multiNodeCommand(endpoint, role), multiNodeRoleEnv(role, workerReplicas))},
+		},
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&template)
+	if err != nil {
+		return nil, fmt.Errorf("converting %s pod template to unstructured: %w", role, err)
+	}
+	return content, nil
+}
+
+func (b *leaderWorkerSetBackend) Cleanup(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
+	lws := &unstructured.Unstructured{}
+	lws.SetGroupVersionKind(leaderWorkerSetGVK)
+	err := b.r.Get(ctx, types.NamespacedName{Name: endpoint.Name, Namespace: endpoint.Namespace}, lws)
+	if err != nil {
+		if errors.IsNotFound(err) || isKindNotRegistered(err) {
+			return nil
+		}
+		return err
+	}
+	if err := b.r.Delete(ctx, lws); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// statefulSetBackend is the MultiNodeReconciler for
+// ServingTopologySpec.Backend "statefulset". It pairs a headless Service
+// (giving each pod a stable DNS name) with a single, homogeneous
+// StatefulSet: every replica shares one pod template, so the entrypoint
+// derives its role and rank from its own pod ordinal at container start
+// rather than the operator assigning it up front.
+type statefulSetBackend struct {
+	r *AIMEndpointReconciler
+}
+
+func (b *statefulSetBackend) Reconcile(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
+	if err := b.reconcileHeadlessService(ctx, endpoint); err != nil {
+		return err
+	}
+	return b.reconcileStatefulSet(ctx, endpoint)
+}
+
+func (b *statefulSetBackend) reconcileHeadlessService(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      multiNodeHeadlessServiceName(endpoint),
+			Namespace: endpoint.Namespace,
+		},
+	}
+
+	_, err := ctrl.CreateOrUpdate(ctx, b.r.Client, svc, func() error {
+		svc.Labels = multiNodeLabels(endpoint)
+		svc.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(endpoint, aimv1alpha1.GroupVersion.WithKind("AIMEndpoint")),
+		}
+		svc.Spec.ClusterIP = corev1.ClusterIPNone
+		svc.Spec.Selector = multiNodeLabels(endpoint)
+		svc.Spec.Ports = []corev1.ServicePort{
+			{Name: "distributed", Port: 6379, TargetPort: intstr.FromInt(6379), Protocol: corev1.ProtocolTCP},
+		}
+		return nil
+	})
+
+	return err
+}
+
+func (b *statefulSetBackend) reconcileStatefulSet(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      endpoint.Name,
+			Namespace: endpoint.Namespace,
+		},
+	}
+
+	_, err := ctrl.CreateOrUpdate(ctx, b.r.Client, sts, func() error {
+		sts.Labels = multiNodeLabels(endpoint)
+		sts.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(endpoint, aimv1alpha1.GroupVersion.WithKind("AIMEndpoint")),
+		}
+
+		workerReplicas := endpoint.Spec.Topology.ResolvedWorkerReplicas()
+		sts.Spec.Replicas = &workerReplicas
+		sts.Spec.ServiceName = multiNodeHeadlessServiceName(endpoint)
+		sts.Spec.Selector = &metav1.LabelSelector{MatchLabels: multiNodeLabels(endpoint)}
+		sts.Spec.Template.ObjectMeta.Labels = multiNodeLabels(endpoint)
+
+		container := b.r.multiNodeServerContainer(endpoint, statefulSetCommand(endpoint), b.ordinalRoleEnv(endpoint, workerReplicas))
+		// Every replica runs the same container spec; statefulSetCommand's
+		// wrapper script picks the leader or worker command at runtime from
+		// $HOSTNAME's ordinal suffix.
+		sts.Spec.Template.Spec.Containers = []corev1.Container{container}
+		sts.Spec.Template.Spec.Affinity = b.r.getTopologyAffinity(endpoint)
+
+		return nil
+	})
+
+	return err
+}
+
+// ordinalRoleEnv sets the env vars statefulSetCommand's wrapper script
+// needs: MASTER_ADDR always points at ordinal 0 (the leader, by the
+// StatefulSet's own pod-naming/DNS guarantee), and WORLD_SIZE is the same
+// for every replica regardless of which one it turns out to be.
+func (b *statefulSetBackend) ordinalRoleEnv(endpoint *aimv1alpha1.AIMEndpoint, workerReplicas int32) []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{Name: "MASTER_ADDR", Value: fmt.Sprintf("%s-0.%s", endpoint.Name, multiNodeHeadlessServiceName(endpoint))},
+		{Name: "WORLD_SIZE", Value: strconv.Itoa(int(workerReplicas))},
+	}
+}
+
+func (b *statefulSetBackend) Cleanup(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) error {
+	sts := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: endpoint.Name, Namespace: endpoint.Namespace}}
+	if err := b.r.Delete(ctx, sts); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: multiNodeHeadlessServiceName(endpoint), Namespace: endpoint.Namespace}}
+	if err := b.r.Delete(ctx, svc); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// workloadStatus is the readiness and replica counts for whichever workload
+// kind currently backs an endpoint (Deployment, StatefulSet, or
+// LeaderWorkerSet), normalized so updateStatus doesn't need to know which one
+// it's looking at.
+type workloadStatus struct {
+	result                         statuscheck.Result
+	current, desired, ready, avail *int32
+}
+
+// getWorkloadStatus fetches whichever workload kind Topology currently
+// selects and normalizes its readiness and replica counts.
+func (r *AIMEndpointReconciler) getWorkloadStatus(ctx context.Context, endpoint *aimv1alpha1.AIMEndpoint) (workloadStatus, error) {
+	if !endpoint.Spec.Topology.IsMultiNode() {
+		deployment := &appsv1.Deployment{}
+		if err := r.Get(ctx, types.NamespacedName{Name: endpoint.Name, Namespace: endpoint.Namespace}, deployment); err != nil && !errors.IsNotFound(err) {
+			return workloadStatus{}, err
+		}
+		status := workloadStatus{result: statuscheck.Deployment(deployment), desired: deployment.Spec.Replicas}
+		if deployment.Status.Replicas > 0 {
+			status.current = &deployment.Status.Replicas
+			status.ready = &deployment.Status.ReadyReplicas
+			status.avail = &deployment.Status.AvailableReplicas
+		}
+		return status, nil
+	}
+
+	if endpoint.Spec.Topology.ResolvedBackend() == aimv1alpha1.MultiNodeBackendStatefulSet {
+		sts := &appsv1.StatefulSet{}
+		if err := r.Get(ctx, types.NamespacedName{Name: endpoint.Name, Namespace: endpoint.Namespace}, sts); err != nil && !errors.IsNotFound(err) {
+			return workloadStatus{}, err
+		}
+		status := workloadStatus{result: statuscheck.StatefulSet(sts), desired: sts.Spec.Replicas}
+		if sts.Status.Replicas > 0 {
+			status.current = &sts.Status.Replicas
+			status.ready = &sts.Status.ReadyReplicas
+		}
+		return status, nil
+	}
+
+	lws := &unstructured.Unstructured{}
+	lws.SetGroupVersionKind(leaderWorkerSetGVK)
+	err := r.Get(ctx, types.NamespacedName{Name: endpoint.Name, Namespace: endpoint.Namespace}, lws)
+	if err != nil {
+		if !errors.IsNotFound(err) && !isKindNotRegistered(err) {
+			return workloadStatus{}, err
+		}
+		lws = &unstructured.Unstructured{}
+		lws.SetGroupVersionKind(leaderWorkerSetGVK)
+		lws.SetName(endpoint.Name)
+	}
+
+	status := workloadStatus{result: statuscheck.LeaderWorkerSet(lws)}
+	if desired, found, _ := unstructured.NestedInt64(lws.Object, "spec", "replicas"); found {
+		d := int32(desired)
+		status.desired = &d
+	}
+	if replicas, found, _ := unstructured.NestedInt64(lws.Object, "status", "replicas"); found && replicas > 0 {
+		c := int32(replicas)
+		status.current = &c
+		if ready, _, _ := unstructured.NestedInt64(lws.Object, "status", "readyReplicas"); ready > 0 {
+			rd := int32(ready)
+			status.ready = &rd
+		}
+	}
+	return status, nil
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package assume provides a thread-safe cache of cached-model status,
+// modeled on the Kubernetes scheduler's AssumeCache. It lets AIMCacheReconciler
+// optimistically reserve a model slot (e.g. "this model is now downloading")
+// before the PVC-side download completes and the AIMCache object itself is
+// updated to say so, so concurrent reconciles that reference the same cache
+// see one consistent entry instead of each fabricating its own guess.
+package assume
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"k8s.io/client-go/tools/cache"
+
+	aimv1alpha1 "github.com/aim-engine/operator/api/v1alpha1"
+)
+
+// Model is one AIMCache's view of one cached model: its status plus the
+// attributes callers index List by.
+type Model struct {
+	// CacheKey is the owning AIMCache's <namespace>/<name>.
+	CacheKey string
+
+	// ModelID is the cached model's ID (ModelCacheSpec.ID / CachedModelStatus.ID).
+	ModelID string
+
+	// Priority and StorageClass mirror ModelCacheSpec.Priority and
+	// StorageSpec.StorageClass at the time this Model was recorded, so List
+	// can group entries without every caller re-joining against the spec.
+	Priority     string
+	StorageClass string
+
+	Status aimv1alpha1.CachedModelStatus
+
+	// ResourceVersion is the owning AIMCache's resourceVersion as of this
+	// observation, used to tell an informer update that confirms an
+	// assumption apart from one that's stale.
+	ResourceVersion string
+}
+
+func modelKey(cacheKey, modelID string) string {
+	return fmt.Sprintf("%s/%s", cacheKey, modelID)
+}
+
+// entry pairs the last API-observed Model with an optimistic "assumed"
+// Model not yet confirmed by the API server, mirroring the scheduler
+// AssumeCache's (apiObj, assumedObj) pair per key.
+type entry struct {
+	key     string
+	api     *Model
+	assumed *Model
+}
+
+// current is what Get/List return: the assumed Model while one is
+// outstanding, otherwise the last API-observed Model.
+func (e *entry) current() *Model {
+	if e.assumed != nil {
+		return e.assumed
+	}
+	return e.api
+}
+
+// Indexer names a cache.Indexers key supported by Cache.List.
+type Indexer string
+
+const (
+	// ByPriority indexes entries by Model.Priority.
+	ByPriority Indexer = "byPriority"
+	// ByStorageClass indexes entries by Model.StorageClass.
+	ByStorageClass Indexer = "byStorageClass"
+)
+
+func byPriorityIndexFunc(obj interface{}) ([]string, error) {
+	e, ok := obj.(*entry)
+	if !ok || e.current() == nil {
+		return nil, nil
+	}
+	return []string{e.current().Priority}, nil
+}
+
+func byStorageClassIndexFunc(obj interface{}) ([]string, error) {
+	e, ok := obj.(*entry)
+	if !ok || e.current() == nil {
+		return nil, nil
+	}
+	return []string{e.current().StorageClass}, nil
+}
+
+func entryKeyFunc(obj interface{}) (string, error) {
+	e, ok := obj.(*entry)
+	if !ok {
+		return "", fmt.Errorf("assume: object is not an *entry")
+	}
+	return e.key, nil
+}
+
+// Cache is a thread-safe store of cached-model status keyed by model ID,
+// modeled on the Kubernetes scheduler's AssumeCache. A reconciler calls
+// Assume to optimistically reserve a model slot ahead of the AIMCache
+// object it lives on actually being updated on the API server; Get and
+// List return that optimistic value until Update delivers an
+// equal-or-newer ResourceVersion for the same key, at which point the
+// assumption is cleared automatically, or until Restore clears it
+// explicitly (e.g. because the thing that was assumed turned out not to
+// happen).
+type Cache struct {
+	mu    sync.RWMutex
+	store cache.Indexer
+}
+
+// NewCache returns an empty Cache with the ByPriority and ByStorageClass indexers installed.
+func NewCache() *Cache {
+	return &Cache{
+		store: cache.NewIndexer(entryKeyFunc, cache.Indexers{
+			string(ByPriority):     byPriorityIndexFunc,
+			string(ByStorageClass): byStorageClassIndexFunc,
+		}),
+	}
+}
+
+// Update records the API server's own view of model. If an Assume is
+// outstanding for the same key and model.ResourceVersion is equal to or
+// newer than the one it was assumed against, the assumption is cleared:
+// reality has caught up and Get/List should go back to serving the real object.
+func (c *Cache) Update(model Model) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := c.getOrCreateLocked(modelKey(model.CacheKey, model.ModelID))
+
+	modelCopy := model
+	e.api = &modelCopy
+
+	if e.assumed != nil && resourceVersionAtLeast(model.ResourceVersion, e.assumed.ResourceVersion) {
+		e.assumed = nil
+	}
+
+	_ = c.store.Update(e)
+}
+
+// Assume optimistically records model as the current status for its key,
+// ahead of the API server reflecting it. Get and List return this value
+// until a later Update observes an equal-or-newer ResourceVersion, or Restore is called.
+func (c *Cache) Assume(model Model) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := c.getOrCreateLocked(modelKey(model.CacheKey, model.ModelID))
+
+	modelCopy := model
+	e.assumed = &modelCopy
+
+	_ = c.store.Update(e)
+}
+
+// Restore discards any outstanding Assume for cacheKey/modelID, falling
+// back to the last API-observed value. Callers use this when an assumed
+// change turns out to be wrong -- e.g. a download that was assumed to
+// succeed actually failed -- mirroring the scheduler's restore-on-conflict path.
+func (c *Cache) Restore(cacheKey, modelID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := modelKey(cacheKey, modelID)
+	e, exists := c.entryLocked(key)
+	if !exists {
+		return
+	}
+
+	e = &entry{key: e.key, api: e.api}
+	if e.api == nil {
+		_ = c.store.Delete(e)
+		return
+	}
+	_ = c.store.Update(e)
+}
+
+// Get returns the current Model for cacheKey/modelID: the assumed version
+// if one is outstanding, otherwise the last API-observed version. ok is
+// false if nothing is known about this key.
+func (c *Cache) Get(cacheKey, modelID string) (model Model, ok bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	e, exists := c.entryLocked(modelKey(cacheKey, modelID))
+	if !exists || e.current() == nil {
+		return Model{}, false
+	}
+	return *e.current(), true
+}
+
+// List returns every current Model, optionally restricted to one indexer
+// value (e.g. List(ByPriority, "high")). An empty indexer lists everything.
+func (c *Cache) List(indexer Indexer, value string) ([]Model, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var objs []interface{}
+	if indexer == "" {
+		objs = c.store.List()
+	} else {
+		var err error
+		objs, err = c.store.ByIndex(string(indexer), value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	models := make([]Model, 0, len(objs))
+	for _, obj := range objs {
+		e, ok := obj.(*entry)
+		if !ok || e.current() == nil {
+			continue
+		}
+		models = append(models, *e.current())
+	}
+	return models, nil
+}
+
+// getOrCreateLocked returns a copy of the *entry for key, creating an empty
+// one if none exists yet. It is a copy so the stored entry keeps its old
+// values until store.Update, which needs them to move the key out of its old
+// index buckets. Callers must hold c.mu for writing.
+func (c *Cache) getOrCreateLocked(key string) *entry {
+	if e, exists := c.entryLocked(key); exists {
+		cp := *e
+		return &cp
+	}
+	return &entry{key: key}
+}
+
+// entryLocked fetches the *entry for key. Callers must hold c.mu.
+func (c *Cache) entryLocked(key string) (*entry, bool) {
+	obj, exists, err := c.store.GetByKey(key)
+	if err != nil || !exists {
+		return nil, false
+	}
+	e, ok := obj.(*entry)
+	return e, ok
+}
+
+// resourceVersionAtLeast reports whether a is numerically >= b.
+// ResourceVersions aren't guaranteed to be numeric by the API contract, but
+// every storage backend this operator targets (etcd) produces monotonically
+// increasing integers in practice; a version we can't parse is treated as
+// newer, so a comparison we can't make doesn't wrongly keep a stale
+// assumption alive forever.
+func resourceVersionAtLeast(a, b string) bool {
+	if a == "" || b == "" {
+		return true
+	}
+	av, aerr := strconv.ParseUint(a, 10, 64)
+	bv, berr := strconv.ParseUint(b, 10, 64)
+	if aerr != nil || berr != nil {
+		return true
+	}
+	return av >= bv
+}
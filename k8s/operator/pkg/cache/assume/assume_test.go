@@ -0,0 +1,193 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assume
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	aimv1alpha1 "github.com/aim-engine/operator/api/v1alpha1"
+)
+
+func TestGetUnknownKeyNotOK(t *testing.T) {
+	c := NewCache()
+	if _, ok := c.Get("default/cache", "model-a"); ok {
+		t.Fatal("Get on an unknown key should report ok=false")
+	}
+}
+
+func TestAssumeIsVisibleBeforeAnyUpdate(t *testing.T) {
+	c := NewCache()
+	c.Assume(Model{CacheKey: "default/cache", ModelID: "model-a", Status: aimv1alpha1.CachedModelStatus{Status: "downloading"}, ResourceVersion: "10"})
+
+	got, ok := c.Get("default/cache", "model-a")
+	if !ok {
+		t.Fatal("Get should return the assumed Model even with no API-observed value yet")
+	}
+	if got.Status.Status != "downloading" {
+		t.Fatalf("Status.Status = %q, want %q", got.Status.Status, "downloading")
+	}
+}
+
+func TestUpdateCaughtUpClearsAssumption(t *testing.T) {
+	c := NewCache()
+	c.Assume(Model{CacheKey: "default/cache", ModelID: "model-a", Status: aimv1alpha1.CachedModelStatus{Status: "downloading"}, ResourceVersion: "10"})
+
+	// The API server's own view catches up to (or passes) the assumed
+	// ResourceVersion, so the assumption should be cleared in favor of it.
+	c.Update(Model{CacheKey: "default/cache", ModelID: "model-a", Status: aimv1alpha1.CachedModelStatus{Status: "cached"}, ResourceVersion: "10"})
+
+	got, ok := c.Get("default/cache", "model-a")
+	if !ok {
+		t.Fatal("Get should still find the entry after Update")
+	}
+	if got.Status.Status != "cached" {
+		t.Fatalf("Status.Status = %q, want %q (assumption should have been cleared)", got.Status.Status, "cached")
+	}
+}
+
+func TestUpdateStaleInformerEventKeepsAssumption(t *testing.T) {
+	c := NewCache()
+	c.Assume(Model{CacheKey: "default/cache", ModelID: "model-a", Status: aimv1alpha1.CachedModelStatus{Status: "downloading"}, ResourceVersion: "10"})
+
+	// A stale informer delivers an event for a ResourceVersion older than the
+	// one the assumption was made against (e.g. it was already behind when
+	// the watch reconnected). The assumption must survive this, otherwise a
+	// reconcile reading Get() in between would wrongly see the pre-assumption
+	// state and could re-dispatch work that's already in flight.
+	c.Update(Model{CacheKey: "default/cache", ModelID: "model-a", Status: aimv1alpha1.CachedModelStatus{Status: "pending"}, ResourceVersion: "5"})
+
+	got, ok := c.Get("default/cache", "model-a")
+	if !ok {
+		t.Fatal("Get should still find the entry after a stale Update")
+	}
+	if got.Status.Status != "downloading" {
+		t.Fatalf("Status.Status = %q, want %q (stale Update must not clear the assumption)", got.Status.Status, "downloading")
+	}
+
+	// A subsequent Update that actually catches up still clears it.
+	c.Update(Model{CacheKey: "default/cache", ModelID: "model-a", Status: aimv1alpha1.CachedModelStatus{Status: "cached"}, ResourceVersion: "11"})
+	got, ok = c.Get("default/cache", "model-a")
+	if !ok {
+		t.Fatal("Get should still find the entry after Update")
+	}
+	if got.Status.Status != "cached" {
+		t.Fatalf("Status.Status = %q, want %q", got.Status.Status, "cached")
+	}
+}
+
+func TestRestoreFallsBackToLastAPIValue(t *testing.T) {
+	c := NewCache()
+	c.Update(Model{CacheKey: "default/cache", ModelID: "model-a", Status: aimv1alpha1.CachedModelStatus{Status: "cached"}, ResourceVersion: "1"})
+	c.Assume(Model{CacheKey: "default/cache", ModelID: "model-a", Status: aimv1alpha1.CachedModelStatus{Status: "evicting"}, ResourceVersion: "2"})
+
+	// The assumed eviction turns out not to happen; Restore should discard it
+	// and fall back to the last API-observed Model rather than leaving the
+	// wrong status visible to other reconciles.
+	c.Restore("default/cache", "model-a")
+
+	got, ok := c.Get("default/cache", "model-a")
+	if !ok {
+		t.Fatal("Get should still find the entry after Restore, falling back to the API value")
+	}
+	if got.Status.Status != "cached" {
+		t.Fatalf("Status.Status = %q, want %q", got.Status.Status, "cached")
+	}
+}
+
+func TestRestoreWithNoAPIValueRemovesEntry(t *testing.T) {
+	c := NewCache()
+	c.Assume(Model{CacheKey: "default/cache", ModelID: "model-a", Status: aimv1alpha1.CachedModelStatus{Status: "downloading"}, ResourceVersion: "10"})
+
+	// No Update has ever been observed for this key, so restoring should
+	// remove the entry entirely rather than leaving a dangling empty one.
+	c.Restore("default/cache", "model-a")
+
+	if _, ok := c.Get("default/cache", "model-a"); ok {
+		t.Fatal("Get should report ok=false once the only (assumed) value has been restored away")
+	}
+}
+
+func TestRestoreOnUnknownKeyIsNoop(t *testing.T) {
+	c := NewCache()
+	c.Restore("default/cache", "does-not-exist")
+	if _, ok := c.Get("default/cache", "does-not-exist"); ok {
+		t.Fatal("Get should report ok=false for a key nothing was ever recorded against")
+	}
+}
+
+func TestListByIndexerReflectsAssumedValues(t *testing.T) {
+	c := NewCache()
+	c.Update(Model{CacheKey: "default/cache", ModelID: "model-a", Priority: "low", Status: aimv1alpha1.CachedModelStatus{Status: "cached"}, ResourceVersion: "1"})
+	c.Assume(Model{CacheKey: "default/cache", ModelID: "model-b", Priority: "high", Status: aimv1alpha1.CachedModelStatus{Status: "downloading"}, ResourceVersion: "2"})
+
+	high, err := c.List(ByPriority, "high")
+	if err != nil {
+		t.Fatalf("List returned an error: %v", err)
+	}
+	if len(high) != 1 || high[0].ModelID != "model-b" {
+		t.Fatalf("List(ByPriority, \"high\") = %v, want just model-b", high)
+	}
+
+	all, err := c.List("", "")
+	if err != nil {
+		t.Fatalf("List returned an error: %v", err)
+	}
+	if len(all) != 2 {
+		t.Fatalf("List with no indexer returned %d entries, want 2", len(all))
+	}
+}
+
+// TestConcurrentAssumeUpdateRestoreRace exercises Assume/Update/Restore from
+// many goroutines against the same and different keys simultaneously. It
+// doesn't assert on a final value -- the interleaving is nondeterministic --
+// it exists to be run with -race so a lock ordering or missing-lock bug
+// trips the race detector instead of only showing up under production load.
+func TestConcurrentAssumeUpdateRestoreRace(t *testing.T) {
+	c := NewCache()
+	const keys = 4
+	const iterations = 200
+
+	var wg sync.WaitGroup
+	for k := 0; k < keys; k++ {
+		modelID := fmt.Sprintf("model-%d", k)
+		wg.Add(3)
+
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				c.Assume(Model{CacheKey: "default/cache", ModelID: modelID, ResourceVersion: fmt.Sprintf("%d", i)})
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				c.Update(Model{CacheKey: "default/cache", ModelID: modelID, ResourceVersion: fmt.Sprintf("%d", i)})
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				c.Restore("default/cache", modelID)
+				_, _ = c.Get("default/cache", modelID)
+				_, _ = c.List(ByPriority, "")
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package evict implements the pluggable eviction strategies behind
+// AIMCache's CleanupSpec.Strategy: given the models currently cached, each
+// Evictor orders them from most to least evictable so AIMCacheReconciler can
+// remove just enough to satisfy CleanupSpec.MinFreeSpace.
+package evict
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Candidate is one cached model the evictor may choose to remove.
+type Candidate struct {
+	ID           string
+	Priority     string
+	SizeBytes    int64
+	AccessCount  int64
+	LastAccessed time.Time
+	CachedAt     time.Time
+
+	// Tier is the storage tier the candidate currently lives on, empty if
+	// the cache has no tiers configured.
+	Tier string
+}
+
+// CleanupSpec.Strategy values, one per Evictor implementation below.
+const (
+	StrategyLRU              = "lru"
+	StrategyLFU              = "lfu"
+	StrategyAge              = "age"
+	StrategyPriorityWeighted = "priority-weighted"
+)
+
+// Strategies lists every strategy name ByName accepts.
+var Strategies = []string{StrategyLRU, StrategyLFU, StrategyAge, StrategyPriorityWeighted}
+
+// Evictor orders eviction candidates from most to least evictable.
+type Evictor interface {
+	// Order returns candidate IDs, most-evictable first.
+	Order(candidates []Candidate) []string
+}
+
+// ByName returns the Evictor for a CleanupSpec.Strategy value. An empty
+// strategy defaults to lru.
+func ByName(strategy string) (Evictor, error) {
+	switch strategy {
+	case StrategyLRU, "":
+		return lruEvictor{}, nil
+	case StrategyLFU:
+		return lfuEvictor{}, nil
+	case StrategyAge:
+		return ageEvictor{}, nil
+	case StrategyPriorityWeighted:
+		return priorityWeightedEvictor{}, nil
+	default:
+		return nil, fmt.Errorf("evict: unknown strategy %q", strategy)
+	}
+}
+
+func ids(candidates []Candidate) []string {
+	out := make([]string, len(candidates))
+	for i, c := range candidates {
+		out[i] = c.ID
+	}
+	return out
+}
+
+// lruEvictor evicts the least-recently-accessed model first.
+type lruEvictor struct{}
+
+func (lruEvictor) Order(candidates []Candidate) []string {
+	ordered := append([]Candidate(nil), candidates...)
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].LastAccessed.Before(ordered[j].LastAccessed) })
+	return ids(ordered)
+}
+
+// lfuEvictor evicts the least-frequently-accessed model first.
+type lfuEvictor struct{}
+
+func (lfuEvictor) Order(candidates []Candidate) []string {
+	ordered := append([]Candidate(nil), candidates...)
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].AccessCount < ordered[j].AccessCount })
+	return ids(ordered)
+}
+
+// ageEvictor evicts the model cached longest ago first, independent of
+// access patterns.
+type ageEvictor struct{}
+
+func (ageEvictor) Order(candidates []Candidate) []string {
+	ordered := append([]Candidate(nil), candidates...)
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].CachedAt.Before(ordered[j].CachedAt) })
+	return ids(ordered)
+}
+
+// priorityWeight maps ModelCacheSpec.Priority to a numeric weight; unset or
+// unrecognized priorities are treated as "normal".
+var priorityWeight = map[string]float64{
+	"low":      0.5,
+	"normal":   1,
+	"high":     2,
+	"critical": 4,
+}
+
+func weightOf(priority string) float64 {
+	if w, ok := priorityWeight[priority]; ok {
+		return w
+	}
+	return priorityWeight["normal"]
+}
+
+// priorityWeightedEvictor combines ModelCacheSpec.Priority with access
+// recency: its score is time-since-last-access divided by priority weight,
+// so a stale low-priority model is evicted long before a frequently-used
+// critical one, but a critical model left completely idle is still
+// eventually evicted rather than pinned forever.
+type priorityWeightedEvictor struct{}
+
+func (priorityWeightedEvictor) Order(candidates []Candidate) []string {
+	ordered := append([]Candidate(nil), candidates...)
+	score := func(c Candidate) float64 {
+		return float64(time.Since(c.LastAccessed)) / weightOf(c.Priority)
+	}
+	sort.Slice(ordered, func(i, j int) bool { return score(ordered[i]) > score(ordered[j]) })
+	return ids(ordered)
+}
+
+// OlderThan returns the IDs of every candidate cached before cutoff, used to
+// enforce CleanupSpec.MaxAge independently of whichever Strategy is selected.
+func OlderThan(candidates []Candidate, cutoff time.Time) []string {
+	var out []string
+	for _, c := range candidates {
+		if c.CachedAt.Before(cutoff) {
+			out = append(out, c.ID)
+		}
+	}
+	return out
+}
+
+// ColderTier returns the tier immediately colder than current within tiers
+// (ordered hottest to coldest), and whether one exists. A cleanup pass uses
+// this to demote a candidate to colder storage instead of deleting it
+// outright whenever the cache has a colder tier left to demote into. A
+// candidate with no assigned tier (not yet classified by a migration pass)
+// is treated as already occupying the coldest tier it could reach, so it
+// falls straight to the cache's coldest tier rather than, backwards, its hottest.
+func ColderTier(tiers []string, current string) (string, bool) {
+	if len(tiers) == 0 {
+		return "", false
+	}
+	if current == "" {
+		return tiers[len(tiers)-1], true
+	}
+	for i, tier := range tiers {
+		if tier == current && i+1 < len(tiers) {
+			return tiers[i+1], true
+		}
+	}
+	return "", false
+}
+
+// PlanMinFreeSpace returns, in strategy order, just enough candidate IDs to
+// evict so that freeing their SizeBytes would bring freeBytes up to
+// minFreeBytes. It returns nil if freeBytes already meets the threshold.
+func PlanMinFreeSpace(candidates []Candidate, strategy string, freeBytes, minFreeBytes int64) ([]string, error) {
+	if freeBytes >= minFreeBytes {
+		return nil, nil
+	}
+
+	evictor, err := ByName(strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	bySize := make(map[string]int64, len(candidates))
+	for _, c := range candidates {
+		bySize[c.ID] = c.SizeBytes
+	}
+
+	var plan []string
+	for _, id := range evictor.Order(candidates) {
+		if freeBytes >= minFreeBytes {
+			break
+		}
+		plan = append(plan, id)
+		freeBytes += bySize[id]
+	}
+	return plan, nil
+}
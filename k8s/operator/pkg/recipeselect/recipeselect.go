@@ -0,0 +1,278 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package recipeselect scores and selects the AIMRecipe that best matches an
+// AIMEndpoint's requested hardware, precision, backend, and GPU count, and
+// explains the decision so it can be recorded on the endpoint for
+// `kubectl describe aimendpoint`.
+package recipeselect
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	aimv1alpha1 "github.com/aim-engine/operator/api/v1alpha1"
+)
+
+// hardwareFamilies maps requested hardware to the recipe hardware targets it
+// can serve within its family, e.g. a newer-generation MI325X node can serve
+// a recipe authored for MI300X.
+var hardwareFamilies = map[string][]string{
+	"MI300X": {"MI300X"},
+	"MI325X": {"MI325X", "MI300X"},
+	"MI355X": {"MI355X", "MI325X", "MI300X"},
+	"MI250":  {"MI250"},
+	"MI210":  {"MI210"},
+}
+
+// Explanation records why a recipe was (or was not) selected for an endpoint
+type Explanation struct {
+	// Selected is the name of the chosen recipe, empty if none matched
+	Selected string
+
+	// Score is the winning recipe's score
+	Score int
+
+	// Reasons lists why the winning recipe scored as it did
+	Reasons []string
+
+	// Rejected lists candidates that were considered but not chosen, and why
+	Rejected []RejectedCandidate
+}
+
+// RejectedCandidate records a recipe that was considered but passed over
+type RejectedCandidate struct {
+	Name   string
+	Reason string
+}
+
+// NodeInventory summarizes the GPU capacity actually present in the cluster,
+// aggregated from node.Status.Allocatable["amd.com/gpu"] and the
+// amd.com/gpu.product label. Select uses it to reject configurations that no
+// single node could ever schedule, rather than only checking the recipe's
+// own declared GPUCount against the request.
+type NodeInventory struct {
+	// MaxSchedulableGPUCount is the largest amd.com/gpu allocatable on any one
+	// schedulable node, i.e. the largest tensor-parallel GPU count a
+	// single-node configuration can actually fit.
+	MaxSchedulableGPUCount int32
+
+	// ProductCounts maps an amd.com/gpu.product label value (e.g. MI300X) to
+	// the number of schedulable nodes advertising it.
+	ProductCounts map[string]int32
+}
+
+// Options augments Score/Select with cluster state beyond the endpoint and
+// recipe specs themselves. The zero value disables every optional check.
+type Options struct {
+	// Inventory, if non-nil, constrains candidates to configurations that fit
+	// a real node and hardware that a real node actually advertises.
+	Inventory *NodeInventory
+
+	// CacheHit, if non-nil, reports whether a model is already warm in an
+	// AIMCache, so a recipe for it is preferred over an equally-good recipe
+	// that would require a cold model download.
+	CacheHit func(modelID string) bool
+}
+
+// Score evaluates how well a recipe matches an endpoint's requested hardware,
+// precision, backend, and GPU count. A higher score is a better match; an
+// error indicates the recipe cannot serve the endpoint at all. The returned
+// GPUConfiguration is the one Score picked to satisfy the requested GPU
+// count, which may have more GPUs than requested if that's the smallest
+// enabled configuration that fits.
+func Score(endpoint aimv1alpha1.AIMEndpointSpec, recipe aimv1alpha1.AIMRecipe, opts Options) (int, []string, *aimv1alpha1.GPUConfiguration, error) {
+	var reasons []string
+	score := 0
+
+	if opts.Inventory != nil && len(opts.Inventory.ProductCounts) > 0 {
+		if opts.Inventory.ProductCounts[recipe.Spec.Hardware] == 0 {
+			return 0, nil, nil, fmt.Errorf("no schedulable node advertises amd.com/gpu.product=%s", recipe.Spec.Hardware)
+		}
+	}
+
+	hardwarePref := endpoint.Resources.Topology.RequestedGPUModel()
+	if hardwarePref != "" {
+		if hardwarePref == recipe.Spec.Hardware {
+			score += 100
+			reasons = append(reasons, fmt.Sprintf("exact hardware match: %s", recipe.Spec.Hardware))
+		} else if familyContains(hardwarePref, recipe.Spec.Hardware) {
+			score += 60
+			reasons = append(reasons, fmt.Sprintf("family-compatible hardware: %s can serve a %s recipe", hardwarePref, recipe.Spec.Hardware))
+		} else {
+			return 0, nil, nil, fmt.Errorf("recipe hardware %s is not compatible with requested %s", recipe.Spec.Hardware, hardwarePref)
+		}
+	}
+
+	precisionPrefs := endpoint.Recipe.ResolvedPrecisionPreferences()
+	if len(precisionPrefs) > 0 {
+		rank := indexOf(precisionPrefs, recipe.Spec.Precision)
+		if rank < 0 {
+			return 0, nil, nil, fmt.Errorf("recipe precision %s is not in the requested preference list %v", recipe.Spec.Precision, precisionPrefs)
+		}
+		score += 30 - rank
+		reasons = append(reasons, fmt.Sprintf("precision %s is preference #%d", recipe.Spec.Precision, rank+1))
+	}
+
+	if endpoint.Recipe.Backend != "" {
+		if endpoint.Recipe.Backend != recipe.Spec.Backend {
+			return 0, nil, nil, fmt.Errorf("recipe backend %s does not match requested backend %s", recipe.Spec.Backend, endpoint.Recipe.Backend)
+		}
+		score += 10
+		reasons = append(reasons, fmt.Sprintf("backend match: %s", recipe.Spec.Backend))
+	}
+
+	requestedGPUCount := int32(1)
+	if endpoint.Resources.GPUCount != nil {
+		requestedGPUCount = *endpoint.Resources.GPUCount
+	}
+
+	maxSchedulable := int32(0)
+	if opts.Inventory != nil {
+		maxSchedulable = opts.Inventory.MaxSchedulableGPUCount
+	}
+
+	config := smallestFittingConfiguration(recipe.Spec.Configurations, requestedGPUCount, maxSchedulable)
+	if config == nil {
+		if maxSchedulable > 0 && maxSchedulable < requestedGPUCount {
+			return 0, nil, nil, fmt.Errorf("no schedulable node has %d amd.com/gpu allocatable to satisfy requested GPU count %d", maxSchedulable, requestedGPUCount)
+		}
+		return 0, nil, nil, fmt.Errorf("no enabled configuration in recipe %s satisfies a tensor-parallel size of %d", recipe.Name, requestedGPUCount)
+	}
+	// Prefer the configuration closest to (but not below) the requested GPU count.
+	score += 20 - int(config.GPUCount-requestedGPUCount)
+	reasons = append(reasons, fmt.Sprintf("GPU count %d satisfies requested %d", config.GPUCount, requestedGPUCount))
+
+	// Newer recipes are preferred over older ones authored for the same
+	// model/hardware/precision.
+	if age := time.Since(recipe.CreationTimestamp.Time); age > 0 {
+		recencyBonus := 10 - int(age.Hours()/(24*30))
+		if recencyBonus < 0 {
+			recencyBonus = 0
+		}
+		if recencyBonus > 0 {
+			score += recencyBonus
+			reasons = append(reasons, fmt.Sprintf("recipe is %.0f days old (+%d recency)", age.Hours()/24, recencyBonus))
+		}
+	}
+
+	if opts.CacheHit != nil && opts.CacheHit(recipe.Spec.ModelID) {
+		score += 15
+		reasons = append(reasons, fmt.Sprintf("model %s is already warm in an AIMCache", recipe.Spec.ModelID))
+	}
+
+	return score, reasons, config, nil
+}
+
+// Select scores every candidate recipe and returns the highest scoring one,
+// the GPUConfiguration Score picked to satisfy the requested GPU count, and
+// an Explanation describing the decision for debuggability.
+func Select(endpoint aimv1alpha1.AIMEndpointSpec, recipes []aimv1alpha1.AIMRecipe, opts Options) (*aimv1alpha1.AIMRecipe, *aimv1alpha1.GPUConfiguration, Explanation, error) {
+	type scored struct {
+		recipe  *aimv1alpha1.AIMRecipe
+		config  *aimv1alpha1.GPUConfiguration
+		score   int
+		reasons []string
+	}
+
+	var candidates []scored
+	explanation := Explanation{}
+
+	for i := range recipes {
+		recipe := &recipes[i]
+		score, reasons, config, err := Score(endpoint, *recipe, opts)
+		if err != nil {
+			explanation.Rejected = append(explanation.Rejected, RejectedCandidate{Name: recipe.Name, Reason: err.Error()})
+			continue
+		}
+		candidates = append(candidates, scored{recipe: recipe, config: config, score: score, reasons: reasons})
+	}
+
+	if len(candidates) == 0 {
+		return nil, nil, explanation, fmt.Errorf("no recipe satisfies the endpoint's hardware/precision/backend/GPU-count requirements")
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].score > candidates[j].score })
+	winner := candidates[0]
+
+	explanation.Selected = winner.recipe.Name
+	explanation.Score = winner.score
+	explanation.Reasons = winner.reasons
+	for _, c := range candidates[1:] {
+		explanation.Rejected = append(explanation.Rejected, RejectedCandidate{
+			Name:   c.recipe.Name,
+			Reason: fmt.Sprintf("scored lower (%d) than %s (%d)", c.score, winner.recipe.Name, winner.score),
+		})
+	}
+
+	return winner.recipe, winner.config, explanation, nil
+}
+
+// smallestFittingConfiguration returns the enabled configuration with the
+// smallest GPUCount that is still >= requestedGPUCount and, if
+// maxSchedulableGPUCount is positive, fits within a single schedulable node.
+func smallestFittingConfiguration(configs []aimv1alpha1.GPUConfiguration, requestedGPUCount, maxSchedulableGPUCount int32) *aimv1alpha1.GPUConfiguration {
+	var best *aimv1alpha1.GPUConfiguration
+	for i := range configs {
+		config := &configs[i]
+		if !config.Enabled || config.GPUCount < requestedGPUCount {
+			continue
+		}
+		if maxSchedulableGPUCount > 0 && config.GPUCount > maxSchedulableGPUCount {
+			continue
+		}
+		if best == nil || config.GPUCount < best.GPUCount {
+			best = config
+		}
+	}
+	return best
+}
+
+func familyContains(requested, recipeHardware string) bool {
+	for _, member := range hardwareFamilies[requested] {
+		if member == recipeHardware {
+			return true
+		}
+	}
+	return false
+}
+
+// CompatibleHardware returns every hardware platform a recipe authored for
+// hardware can satisfy, including hardware itself and any newer-generation
+// family members. Used to publish AIMRecipeStatus.Compatibility.
+func CompatibleHardware(hardware string) []string {
+	var compatible []string
+	for requested := range hardwareFamilies {
+		if familyContains(requested, hardware) {
+			compatible = append(compatible, requested)
+		}
+	}
+	if len(compatible) == 0 {
+		return []string{hardware}
+	}
+	sort.Strings(compatible)
+	return compatible
+}
+
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2024 AMD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package statuscheck computes per-resource readiness for the Kubernetes
+// resources an AIMEndpoint owns (Deployment, StatefulSet, LeaderWorkerSet,
+// Service, PersistentVolumeClaim, HorizontalPodAutoscaler, ConfigMap, Pods),
+// following the same rules Helm 3.5+ and kstatus use to decide whether a
+// release has rolled out. A reconciler combines these per-resource Results
+// into its own status conditions rather than reading a single
+// ReadyReplicas count.
+package statuscheck
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Status is the coarse-grained readiness of a single resource, mirroring kstatus's vocabulary.
+type Status string
+
+const (
+	// StatusCurrent means the resource has reached its desired state
+	StatusCurrent Status = "Current"
+
+	// StatusInProgress means the resource is still rolling out
+	StatusInProgress Status = "InProgress"
+
+	// StatusFailed means the resource has entered a state it cannot recover from
+	// without intervention, e.g. CrashLoopBackOff or ImagePullBackOff
+	StatusFailed Status = "Failed"
+
+	// StatusNotFound means the resource has not been created yet
+	StatusNotFound Status = "NotFound"
+)
+
+// Result is the computed status of a single owned resource.
+type Result struct {
+	// Resource identifies what was checked, e.g. "Deployment/my-endpoint"
+	Resource string
+
+	// Status is the coarse-grained readiness of Resource
+	Status Status
+
+	// Reason is a short CamelCase machine-readable reason, suitable for a metav1.Condition.Reason
+	Reason string
+
+	// Message is a human-readable explanation of Status/Reason
+	Message string
+}
+
+// Deployment reports readiness using the same checks Helm 3.5+ uses: compare
+// UpdatedReplicas/AvailableReplicas against the desired replica count, require
+// ObservedGeneration to have caught up to Generation, and require the
+// Progressing condition's reason to be NewReplicaSetAvailable.
+func Deployment(dep *appsv1.Deployment) Result {
+	resource := fmt.Sprintf("Deployment/%s", dep.Name)
+
+	if dep.Generation > 0 && dep.Status.ObservedGeneration < dep.Generation {
+		return Result{Resource: resource, Status: StatusInProgress, Reason: "ObservedGenerationStale", Message: "waiting for the deployment controller to observe the latest spec"}
+	}
+
+	desired := int32(1)
+	if dep.Spec.Replicas != nil {
+		desired = *dep.Spec.Replicas
+	}
+
+	for _, cond := range dep.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing {
+			if cond.Status == corev1.ConditionFalse {
+				return Result{Resource: resource, Status: StatusFailed, Reason: cond.Reason, Message: cond.Message}
+			}
+			if cond.Status == corev1.ConditionTrue && cond.Reason != "NewReplicaSetAvailable" {
+				return Result{Resource: resource, Status: StatusInProgress, Reason: cond.Reason, Message: cond.Message}
+			}
+		}
+		if cond.Type == appsv1.DeploymentReplicaFailure && cond.Status == corev1.ConditionTrue {
+			return Result{Resource: resource, Status: StatusFailed, Reason: cond.Reason, Message: cond.Message}
+		}
+	}
+
+	if dep.Status.UpdatedReplicas < desired {
+		return Result{Resource: resource, Status: StatusInProgress, Reason: "UpdateInProgress", Message: fmt.Sprintf("%d of %d replicas updated", dep.Status.UpdatedReplicas, desired)}
+	}
+	if dep.Status.Replicas > dep.Status.UpdatedReplicas {
+		return Result{Resource: resource, Status: StatusInProgress, Reason: "OldReplicasPending", Message: "old replicas are still terminating"}
+	}
+	if dep.Status.AvailableReplicas < desired {
+		return Result{Resource: resource, Status: StatusInProgress, Reason: "AvailableReplicasPending", Message: fmt.Sprintf("%d of %d replicas available", dep.Status.AvailableReplicas, desired)}
+	}
+
+	return Result{Resource: resource, Status: StatusCurrent, Reason: "NewReplicaSetAvailable", Message: "deployment has minimum availability"}
+}
+
+// StatefulSet reports readiness using the same replica-count comparisons as
+// Deployment, adapted to StatefulSet's status fields: it has no Progressing
+// condition of its own, so there's nothing to check beyond replica counts.
+func StatefulSet(sts *appsv1.StatefulSet) Result {
+	resource := fmt.Sprintf("StatefulSet/%s", sts.Name)
+
+	if sts.Generation > 0 && sts.Status.ObservedGeneration < sts.Generation {
+		return Result{Resource: resource, Status: StatusInProgress, Reason: "ObservedGenerationStale", Message: "waiting for the statefulset controller to observe the latest spec"}
+	}
+
+	desired := int32(1)
+	if sts.Spec.Replicas != nil {
+		desired = *sts.Spec.Replicas
+	}
+
+	if sts.Status.UpdatedReplicas < desired {
+		return Result{Resource: resource, Status: StatusInProgress, Reason: "UpdateInProgress", Message: fmt.Sprintf("%d of %d replicas updated", sts.Status.UpdatedReplicas, desired)}
+	}
+	if sts.Status.ReadyReplicas < desired {
+		return Result{Resource: resource, Status: StatusInProgress, Reason: "ReadyReplicasPending", Message: fmt.Sprintf("%d of %d replicas ready", sts.Status.ReadyReplicas, desired)}
+	}
+
+	return Result{Resource: resource, Status: StatusCurrent, Reason: "AllReplicasReady", Message: "statefulset has the desired number of ready replicas"}
+}
+
+// LeaderWorkerSet reports readiness from a leaderworkerset.x-k8s.io
+// LeaderWorkerSet read as unstructured content, since that CRD isn't part of
+// this operator's scheme. Its status mirrors StatefulSet's: replicas,
+// readyReplicas, updatedReplicas count leader-worker groups, not individual pods.
+func LeaderWorkerSet(obj *unstructured.Unstructured) Result {
+	resource := fmt.Sprintf("LeaderWorkerSet/%s", obj.GetName())
+
+	desired := int64(1)
+	if v, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas"); found {
+		desired = v
+	}
+	ready, _, _ := unstructured.NestedInt64(obj.Object, "status", "readyReplicas")
+	updated, _, _ := unstructured.NestedInt64(obj.Object, "status", "updatedReplicas")
+
+	if updated < desired {
+		return Result{Resource: resource, Status: StatusInProgress, Reason: "UpdateInProgress", Message: fmt.Sprintf("%d of %d groups updated", updated, desired)}
+	}
+	if ready < desired {
+		return Result{Resource: resource, Status: StatusInProgress, Reason: "ReadyReplicasPending", Message: fmt.Sprintf("%d of %d groups ready", ready, desired)}
+	}
+
+	return Result{Resource: resource, Status: StatusCurrent, Reason: "AllReplicasReady", Message: "leaderworkerset has the desired number of ready groups"}
+}
+
+// Pod reports readiness by walking container statuses to distinguish a pod that
+// is merely starting up from one stuck in CrashLoopBackOff/ImagePullBackOff, or
+// Pending because the scheduler can't satisfy a GPU request.
+func Pod(pod *corev1.Pod) Result {
+	resource := fmt.Sprintf("Pod/%s", pod.Name)
+
+	switch pod.Status.Phase {
+	case corev1.PodRunning, corev1.PodSucceeded:
+		// fall through to container status inspection below
+	case corev1.PodPending:
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse {
+				reason := "Pending"
+				if cond.Reason == corev1.PodReasonUnschedulable {
+					reason = "GPUSchedulingPending"
+				}
+				return Result{Resource: resource, Status: StatusInProgress, Reason: reason, Message: cond.Message}
+			}
+		}
+		return Result{Resource: resource, Status: StatusInProgress, Reason: "Pending", Message: "pod has not been scheduled yet"}
+	case corev1.PodFailed:
+		return Result{Resource: resource, Status: StatusFailed, Reason: "PodFailed", Message: pod.Status.Message}
+	}
+
+	for _, cs := range append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...) {
+		if cs.State.Waiting == nil {
+			continue
+		}
+		switch cs.State.Waiting.Reason {
+		case "CrashLoopBackOff":
+			return Result{Resource: resource, Status: StatusFailed, Reason: "CrashLoopBackOff", Message: fmt.Sprintf("container %s: %s", cs.Name, cs.State.Waiting.Message)}
+		case "ImagePullBackOff", "ErrImagePull":
+			return Result{Resource: resource, Status: StatusFailed, Reason: "ImagePullBackOff", Message: fmt.Sprintf("container %s: %s", cs.Name, cs.State.Waiting.Message)}
+		}
+	}
+
+	for _, cs := range pod.Status.ContainerStatuses {
+		if !cs.Ready {
+			return Result{Resource: resource, Status: StatusInProgress, Reason: "ContainerNotReady", Message: fmt.Sprintf("container %s is not ready", cs.Name)}
+		}
+	}
+
+	return Result{Resource: resource, Status: StatusCurrent, Reason: "PodReady", Message: "pod is running and ready"}
+}
+
+// PVC requires the claim to have reached the Bound phase.
+func PVC(pvc *corev1.PersistentVolumeClaim) Result {
+	resource := fmt.Sprintf("PersistentVolumeClaim/%s", pvc.Name)
+
+	switch pvc.Status.Phase {
+	case corev1.ClaimBound:
+		return Result{Resource: resource, Status: StatusCurrent, Reason: "Bound", Message: "claim is bound"}
+	case corev1.ClaimLost:
+		return Result{Resource: resource, Status: StatusFailed, Reason: "ClaimLost", Message: "persistent volume backing this claim was lost"}
+	default:
+		return Result{Resource: resource, Status: StatusInProgress, Reason: "Binding", Message: fmt.Sprintf("claim is %s", pvc.Status.Phase)}
+	}
+}
+
+// Service requires at least one LoadBalancer ingress before the endpoint's
+// external address is published, avoiding an index-out-of-range panic on the
+// empty-ingress-list case.
+func Service(svc *corev1.Service) Result {
+	resource := fmt.Sprintf("Service/%s", svc.Name)
+
+	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return Result{Resource: resource, Status: StatusCurrent, Reason: "ServiceCreated", Message: "service does not require a load balancer ingress"}
+	}
+
+	if len(svc.Status.LoadBalancer.Ingress) == 0 {
+		return Result{Resource: resource, Status: StatusInProgress, Reason: "LoadBalancerPending", Message: "waiting for a load balancer ingress to be assigned"}
+	}
+
+	return Result{Resource: resource, Status: StatusCurrent, Reason: "LoadBalancerReady", Message: "load balancer ingress is assigned"}
+}
+
+// HPA is considered Current unless its AbleToScale condition is False: the
+// HPA's own target replica count is a function of Deployment/Pod status already
+// checked above, so a missing AbleToScale condition doesn't by itself block readiness.
+func HPA(hpa *autoscalingv2.HorizontalPodAutoscaler) Result {
+	resource := fmt.Sprintf("HorizontalPodAutoscaler/%s", hpa.Name)
+
+	for _, cond := range hpa.Status.Conditions {
+		if cond.Type == autoscalingv2.AbleToScale && cond.Status == corev1.ConditionFalse {
+			return Result{Resource: resource, Status: StatusInProgress, Reason: cond.Reason, Message: cond.Message}
+		}
+	}
+
+	return Result{Resource: resource, Status: StatusCurrent, Reason: "AbleToScale", Message: "autoscaler is able to scale the target"}
+}
+
+// ConfigMap is Current as soon as it exists; it has no further readiness state.
+func ConfigMap(cm *corev1.ConfigMap) Result {
+	return Result{Resource: fmt.Sprintf("ConfigMap/%s", cm.Name), Status: StatusCurrent, Reason: "Exists", Message: "config map exists"}
+}
+
+// Aggregate reduces a set of per-resource Results to the single worst status:
+// any Failed result wins outright, otherwise the first InProgress/NotFound
+// result is returned as it is, otherwise every resource is Current.
+func Aggregate(results []Result) Result {
+	var inProgress *Result
+	for i := range results {
+		result := results[i]
+		if result.Status == StatusFailed {
+			return result
+		}
+		if result.Status != StatusCurrent && inProgress == nil {
+			inProgress = &result
+		}
+	}
+
+	if inProgress != nil {
+		return *inProgress
+	}
+
+	return Result{Status: StatusCurrent, Reason: "AllResourcesReady", Message: "every owned resource has reached its desired state"}
+}